@@ -18,13 +18,15 @@ use redis::cluster_routing::{
     MultipleNodeRoutingInfo, Route, RoutingInfo, SingleNodeRoutingInfo, SlotAddr,
 };
 use redis::cluster_routing::{ResponsePolicy, Routable};
-use redis::{ClusterScanArgs, RedisError};
+use redis::{ClusterScanArgs, ErrorKind, RedisError};
 use redis::{Cmd, RedisResult, Value};
+use std::collections::HashMap;
 use std::ffi::CStr;
 use std::future::Future;
 use std::slice::from_raw_parts;
 use std::str;
-use std::sync::Arc;
+use std::sync::{Arc, Mutex};
+use std::time::Duration;
 use std::{
     ffi::{CString, c_void},
     mem,
@@ -32,6 +34,7 @@ use std::{
 };
 use tokio::runtime::Builder;
 use tokio::runtime::Runtime;
+use tokio::sync::oneshot;
 
 /// Store a Lua script in the script cache and return its SHA1 hash.
 ///
@@ -316,6 +319,8 @@ pub enum ClientType {
 pub struct ClientAdapter {
     runtime: Runtime,
     core: Arc<CommandExecutionCore>,
+    /// Senders cancelling the in-flight requests of async clients, keyed by the channel of the request.
+    cancellations: Arc<Mutex<HashMap<usize, oneshot::Sender<()>>>>,
 }
 
 struct CommandExecutionCore {
@@ -326,9 +331,16 @@ struct CommandExecutionCore {
 impl ClientAdapter {
     /// Executes a command and routes the result based on client type.
     ///
-    /// For async clients, spawns the future and returns null immediately.
+    /// For async clients, spawns the future and returns null immediately. When `cancellable` is set, the request can be
+    /// cancelled with [`cancel_command`] until its callback is invoked. It must be unset for the requests sharing their
+    /// channel with other in-flight requests, whose cancellation senders would replace each other.
     /// For sync clients, blocks on the future and returns a `CommandResult`.
-    fn execute_command<Fut>(&self, channel: usize, request_future: Fut) -> *mut CommandResult
+    fn execute_command<Fut>(
+        &self,
+        channel: usize,
+        cancellable: bool,
+        request_future: Fut,
+    ) -> *mut CommandResult
     where
         Fut: Future<Output = RedisResult<Value>> + Send + 'static,
     {
@@ -337,9 +349,32 @@ impl ClientAdapter {
                 success_callback,
                 failure_callback,
             } => {
+                let cancel_receiver = cancellable.then(|| {
+                    let (cancel_sender, cancel_receiver) = oneshot::channel();
+                    self.cancellations
+                        .lock()
+                        .unwrap()
+                        .insert(channel, cancel_sender);
+                    cancel_receiver
+                });
+                let cancellations = self.cancellations.clone();
                 // Spawn the request for async client
                 self.runtime.spawn(async move {
-                    let result = request_future.await;
+                    let result = match cancel_receiver {
+                        Some(cancel_receiver) => {
+                            let result = tokio::select! {
+                                result = request_future => result,
+                                Ok(()) = cancel_receiver => Err(RedisError::from((
+                                    ErrorKind::ClientError,
+                                    "Request was cancelled",
+                                ))),
+                            };
+                            // The channel may be reused by another request once the callback is invoked.
+                            cancellations.lock().unwrap().remove(&channel);
+                            result
+                        }
+                        None => request_future.await,
+                    };
                     Self::handle_result(
                         result,
                         Some(success_callback),
@@ -583,7 +618,11 @@ fn create_client_internal(
         client,
         client_type,
     });
-    let client_adapter = Arc::new(ClientAdapter { runtime, core });
+    let client_adapter = Arc::new(ClientAdapter {
+        runtime,
+        core,
+        cancellations: Arc::new(Mutex::new(HashMap::new())),
+    });
     // Clone client_adapter before moving it into the async block
     let client_adapter_ptr = Arc::as_ptr(&client_adapter).addr();
 
@@ -950,6 +989,8 @@ fn valkey_value_to_command_response(value: Value) -> RedisResult<CommandResponse
 /// * `route_bytes` is an optional array of bytes that will be parsed into a Protobuf `Routes` object. The array must be allocated by the caller and subsequently freed by the caller after this function returns.
 /// * `route_bytes_len` is the number of bytes in `route_bytes`. It must also not be greater than the max value of a signed pointer-sized integer.
 /// * `route_bytes_len` must be 0 if `route_bytes` is null.
+/// * `timeout_ms` is the request timeout of the command in milliseconds, replacing the client's request timeout. 0 uses the client's request timeout.
+/// * `cancellable` sets whether the command can be cancelled with [`cancel_command`]. It must be false when `channel` is shared by several in-flight commands, such as the fire-and-forget commands of a batch, since only one of them could be cancelled.
 /// * This function should only be called should with a `client_adapter_ptr` created by [`create_client`], before [`close_client`] was called with the pointer.
 #[unsafe(no_mangle)]
 pub unsafe extern "C" fn command(
@@ -961,6 +1002,8 @@ pub unsafe extern "C" fn command(
     args_len: *const c_ulong,
     route_bytes: *const u8,
     route_bytes_len: usize,
+    timeout_ms: u32,
+    cancellable: bool,
 ) -> *mut CommandResult {
     let client_adapter = unsafe {
         // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
@@ -990,14 +1033,41 @@ pub unsafe extern "C" fn command(
         Routes::default()
     };
 
+    let timeout = (timeout_ms > 0).then_some(Duration::from_millis(timeout_ms.into()));
     let mut client = client_adapter.core.client.clone();
-    client_adapter.execute_command(channel, async move {
+    client_adapter.execute_command(channel, cancellable, async move {
         client
-            .send_command(&cmd, get_route(route, Some(&cmd)))
+            .send_command_with_timeout(&cmd, get_route(route, Some(&cmd)), timeout)
             .await
     })
 }
 
+/// Cancels an in-flight request of an async client. The request is dropped by the client, and its `failure_callback` is
+/// invoked with a cancellation error, unless the request already completed. Requests of sync clients can't be cancelled.
+///
+/// # Safety
+///
+/// * `client_adapter_ptr` must not be `null` and must be obtained from the `ConnectionResponse` returned from [`create_client`].
+/// * `channel` must be the channel passed to the request, such as with [`command`].
+/// * This function should only be called with a `client_adapter_ptr` created by [`create_client`], before [`close_client`] was called with the pointer.
+#[unsafe(no_mangle)]
+pub unsafe extern "C" fn cancel_command(client_adapter_ptr: *const c_void, channel: usize) {
+    let client_adapter = unsafe {
+        // we increment the strong count to ensure that the client is not dropped just because we turned it into an Arc.
+        Arc::increment_strong_count(client_adapter_ptr);
+        Arc::from_raw(client_adapter_ptr as *mut ClientAdapter)
+    };
+    let cancel_sender = client_adapter
+        .cancellations
+        .lock()
+        .unwrap()
+        .remove(&channel);
+    if let Some(cancel_sender) = cancel_sender {
+        // The request may complete before receiving the cancellation, in which case it is ignored.
+        let _ = cancel_sender.send(());
+    }
+}
+
 /// Creates a heap-allocated `CommandResult` containing a `CommandError`.
 ///
 /// This function is used to construct an error response when a Valkey command fails,
@@ -1248,7 +1318,7 @@ pub unsafe extern "C" fn request_cluster_scan(
         Err(_error) => ScanStateRC::new(),
     };
     let mut client = client_adapter.core.client.clone();
-    client_adapter.execute_command(channel, async move {
+    client_adapter.execute_command(channel, true, async move {
         client
             .cluster_scan(&scan_state_cursor, cluster_scan_args)
             .await
@@ -1288,7 +1358,7 @@ pub unsafe extern "C" fn update_connection_password(
         Some(password.to_string())
     };
     let mut client = client_adapter.core.client.clone();
-    client_adapter.execute_command(channel, async move {
+    client_adapter.execute_command(channel, true, async move {
         client
             .update_connection_password(password_option, immediate_auth)
             .await
@@ -1375,7 +1445,7 @@ pub unsafe extern "C" fn invoke_script(
     };
 
     let mut client = client_adapter.core.client.clone();
-    client_adapter.execute_command(channel, async move {
+    client_adapter.execute_command(channel, true, async move {
         client
             .invoke_script(hash_str, &keys_vec, &args_vec, get_route(route, None))
             .await
@@ -571,6 +571,11 @@ impl Client {
     pub fn update_password(&mut self, password: Option<String>) {
         self.connection_info.redis.password = password;
     }
+
+    /// Updates the database in connection_info.
+    pub fn update_database(&mut self, database_id: i64) {
+        self.connection_info.redis.db = database_id;
+    }
 }
 
 #[cfg(feature = "aio")]
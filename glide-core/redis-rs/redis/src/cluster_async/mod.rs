@@ -329,6 +329,22 @@ where
             .await
     }
 
+    /// Update the logical database selected by the connections opened from now on, including reconnections. Returns the
+    /// database previously selected.
+    pub async fn update_connection_database(&mut self, database_id: i64) -> RedisResult<i64> {
+        match self
+            .route_operation_request(Operation::UpdateConnectionDatabase(database_id))
+            .await?
+        {
+            Value::Int(previous) => Ok(previous),
+            value => Err(RedisError::from((
+                ErrorKind::ClientError,
+                "Unexpected reply to a database update",
+                format!("{value:?}"),
+            ))),
+        }
+    }
+
     /// Get the username used to authenticate with all cluster servers
     pub async fn get_username(&mut self) -> RedisResult<Value> {
         self.route_operation_request(Operation::GetUsername).await
@@ -652,6 +668,7 @@ enum CmdArg<C> {
 #[derive(Clone)]
 enum Operation {
     UpdateConnectionPassword(Option<String>),
+    UpdateConnectionDatabase(i64),
     GetUsername,
 }
 
@@ -2341,6 +2358,14 @@ where
                         .expect(MUTEX_WRITE_ERR);
                     Ok(Response::Single(Value::Okay))
                 }
+                Operation::UpdateConnectionDatabase(database_id) => {
+                    let mut previous = 0;
+                    core.set_cluster_param(|params| {
+                        previous = std::mem::replace(&mut params.database_id, database_id)
+                    })
+                    .expect(MUTEX_WRITE_ERR);
+                    Ok(Response::Single(Value::Int(previous)))
+                }
                 Operation::GetUsername => {
                     let username = match core
                         .get_cluster_param(|params| params.username.clone())
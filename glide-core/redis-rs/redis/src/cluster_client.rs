@@ -46,6 +46,7 @@ struct BuilderParams {
     #[cfg(feature = "cluster-async")]
     slots_refresh_rate_limit: SlotsRefreshRateLimit,
     client_name: Option<String>,
+    database_id: i64,
     response_timeout: Option<Duration>,
     protocol: ProtocolVersion,
     pubsub_subscriptions: Option<PubSubSubscriptionInfo>,
@@ -145,6 +146,8 @@ pub struct ClusterParams {
     pub(crate) connections_validation_interval: Option<Duration>,
     pub(crate) tls_params: Option<TlsConnParams>,
     pub(crate) client_name: Option<String>,
+    /// database_id is the logical database selected by every connection.
+    pub(crate) database_id: i64,
     pub(crate) connection_timeout: Duration,
     pub(crate) response_timeout: Duration,
     pub(crate) protocol: ProtocolVersion,
@@ -179,6 +182,7 @@ impl ClusterParams {
             connections_validation_interval: value.connections_validation_interval,
             tls_params,
             client_name: value.client_name,
+            database_id: value.database_id,
             response_timeout: value.response_timeout.unwrap_or(Duration::MAX),
             protocol: value.protocol,
             pubsub_subscriptions: value.pubsub_subscriptions,
@@ -312,6 +316,12 @@ impl ClusterClientBuilder {
         self
     }
 
+    /// Sets the logical database selected by the connections of the new ClusterClient.
+    pub fn database_id(mut self, database_id: i64) -> ClusterClientBuilder {
+        self.builder_params.database_id = database_id;
+        self
+    }
+
     /// Sets password for the new ClusterClient.
     pub fn password(mut self, password: String) -> ClusterClientBuilder {
         self.builder_params.password = Some(password);
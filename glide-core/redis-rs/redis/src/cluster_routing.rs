@@ -545,8 +545,8 @@ impl ResponsePolicy {
             | b"CLIENT SETINFO" | b"CONFIG SET" | b"CONFIG RESETSTAT" | b"CONFIG REWRITE"
             | b"FLUSHALL" | b"FLUSHDB" | b"FUNCTION DELETE" | b"FUNCTION FLUSH"
             | b"FUNCTION LOAD" | b"FUNCTION RESTORE" | b"MEMORY PURGE" | b"MSET" | b"JSON.MSET"
-            | b"PING" | b"SCRIPT FLUSH" | b"SCRIPT LOAD" | b"SLOWLOG RESET" | b"UNWATCH"
-            | b"WATCH" => Some(ResponsePolicy::AllSucceeded),
+            | b"PING" | b"SCRIPT FLUSH" | b"SCRIPT LOAD" | b"SELECT" | b"SLOWLOG RESET"
+            | b"SWAPDB" | b"UNWATCH" | b"WATCH" => Some(ResponsePolicy::AllSucceeded),
 
             b"KEYS"
             | b"FT._ALIASLIST"
@@ -622,7 +622,8 @@ fn base_routing(cmd: &[u8]) -> RouteBy {
         | b"PUBSUB SHARDNUMSUB"
         | b"SCRIPT KILL"
         | b"FUNCTION KILL"
-        | b"FUNCTION STATS" => RouteBy::AllNodes,
+        | b"FUNCTION STATS"
+        | b"SELECT" => RouteBy::AllNodes,
 
         b"DBSIZE"
         | b"DEBUG"
@@ -645,6 +646,7 @@ fn base_routing(cmd: &[u8]) -> RouteBy {
         | b"UNWATCH"
         | b"WAIT"
         | b"RANDOMKEY"
+        | b"SWAPDB"
         | b"WAITAOF" => RouteBy::AllPrimaries,
 
         b"MGET" | b"DEL" | b"EXISTS" | b"UNLINK" | b"TOUCH" | b"WATCH" => {
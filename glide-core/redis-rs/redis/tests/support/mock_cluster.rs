@@ -171,12 +171,24 @@ impl cluster_async::Connect for MockConnection {
             ConnectionIPReturnType::None => None,
         };
 
+        let handler = conn_utils.get_handler();
+        // Like the real connections, select the database of the connection info when connecting.
+        if info.redis.db != 0 {
+            let select = redis::cmd("SELECT").arg(info.redis.db).get_packed_command();
+            if !matches!(handler(&select, port), Err(Ok(Value::Okay))) {
+                return Box::pin(future::err(RedisError::from((
+                    ErrorKind::ResponseError,
+                    "Redis server refused to switch database",
+                ))));
+            }
+        }
+
         Box::pin(future::ok((
             MockConnection {
                 id: conn_utils
                     .connection_id_provider
                     .fetch_add(1, Ordering::SeqCst),
-                handler: conn_utils.get_handler(),
+                handler,
                 port,
             },
             ip,
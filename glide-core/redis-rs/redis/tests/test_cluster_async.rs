@@ -2390,6 +2390,79 @@ mod cluster_async {
         );
     }
 
+    #[test]
+    #[serial_test::serial]
+    fn test_async_cluster_update_connection_database_applies_to_reconnections() {
+        let name = "test_async_cluster_update_connection_database_applies_to_reconnections";
+
+        let should_reconnect = Arc::new(AtomicBool::new(false));
+        let should_reconnect_clone = should_reconnect.clone();
+        let selects = Arc::new(std::sync::Mutex::new(Vec::<Vec<u8>>::new()));
+        let selects_clone = selects.clone();
+
+        let MockEnv {
+            runtime,
+            async_connection: mut connection,
+            handler: _handler,
+            ..
+        } = MockEnv::with_client_builder(
+            ClusterClient::builder(vec![&*format!("redis://{name}")]).retries(0),
+            name,
+            move |cmd: &[u8], port| {
+                respond_startup(name, cmd)?;
+
+                if contains_slice(cmd, b"SELECT") {
+                    selects.lock().unwrap().push(cmd.to_vec());
+                    Err(Ok(Value::Okay))
+                } else if contains_slice(cmd, b"ECHO") && port == 6379 {
+                    if should_reconnect.swap(false, Ordering::SeqCst) {
+                        Err(Err(broken_pipe_error()))
+                    } else {
+                        Err(Ok(Value::BulkString(b"PONG".to_vec())))
+                    }
+                } else {
+                    panic!("unexpected command {cmd:?}")
+                }
+            },
+        );
+
+        // Drops the connection to the node, and sends a command on the connection reconnecting to it.
+        let reconnect = |connection: &mut ClusterConnection<MockConnection>| {
+            should_reconnect_clone.store(true, Ordering::SeqCst);
+            let route = RoutingInfo::SingleNode(SingleNodeRoutingInfo::ByAddress {
+                host: name.to_string(),
+                port: 6379,
+            });
+            let value = runtime.block_on(connection.route_command(&cmd("ECHO"), route.clone()));
+            assert_eq!(
+                value.unwrap_err().to_string(),
+                broken_pipe_error().to_string()
+            );
+            let value = runtime.block_on(connection.route_command(&cmd("ECHO"), route));
+            assert_eq!(value, Ok(Value::BulkString(b"PONG".to_vec())));
+        };
+
+        // The connections of the default database don't select it.
+        assert!(selects_clone.lock().unwrap().is_empty());
+
+        let previous = runtime.block_on(connection.update_connection_database(3));
+        assert_eq!(previous, Ok(0));
+        reconnect(&mut connection);
+        let select_3 = cmd("SELECT").arg(3).get_packed_command();
+        let selected = std::mem::take(&mut *selects_clone.lock().unwrap());
+        assert!(!selected.is_empty());
+        assert!(
+            selected.iter().all(|select| *select == select_3),
+            "{selected:?}"
+        );
+
+        // Rolling the database back, as done when SELECT fails, keeps the reconnections on the previous database.
+        let previous = runtime.block_on(connection.update_connection_database(0));
+        assert_eq!(previous, Ok(3));
+        reconnect(&mut connection);
+        assert!(selects_clone.lock().unwrap().is_empty());
+    }
+
     #[test]
     #[serial_test::serial]
     fn test_async_cluster_refresh_slots_rate_limiter_skips_refresh() {
@@ -123,6 +123,43 @@ impl Drop for GlideRt {
     }
 }
 
+/// Returns the database selected by `cmd`, if it is a SELECT command.
+fn get_selected_database(cmd: &Cmd) -> Option<i64> {
+    if cmd.command()? != b"SELECT" {
+        return None;
+    }
+    std::str::from_utf8(cmd.arg_idx(1)?).ok()?.parse().ok()
+}
+
+/// Selects the database on the connections to every node of the standalone client, and on their reconnections.
+async fn select_standalone_database(client: &mut StandaloneClient, cmd: &Cmd) -> RedisResult<Value> {
+    let database_id = get_selected_database(cmd).unwrap_or_default();
+    // The database is updated before SELECT is sent, so that the connections reconnecting meanwhile select it too.
+    let previous = client.update_connection_database(database_id);
+    let result = client.send_command(cmd).await;
+    if result.is_err() {
+        client.update_connection_database(previous);
+    }
+    result
+}
+
+/// Selects the database on every connection of the cluster client, and on the connections opened later, such as
+/// reconnections and connections to new nodes.
+async fn select_cluster_database(client: &mut ClusterConnection, cmd: &Cmd) -> RedisResult<Value> {
+    let database_id = get_selected_database(cmd).unwrap_or_default();
+    // The database is updated before SELECT is sent, so that the connections opened meanwhile select it too.
+    let previous = client.update_connection_database(database_id).await?;
+    let routing = RoutingInfo::MultiNode((
+        MultipleNodeRoutingInfo::AllNodes,
+        Some(ResponsePolicy::AllSucceeded),
+    ));
+    let result = client.route_command(cmd, routing).await;
+    if result.is_err() {
+        client.update_connection_database(previous).await?;
+    }
+    result
+}
+
 pub(super) fn get_port(address: &NodeAddress) -> u16 {
     const DEFAULT_PORT: u16 = 6379;
     if address.port == 0 {
@@ -326,9 +363,21 @@ impl Client {
         &'a mut self,
         cmd: &'a Cmd,
         routing: Option<RoutingInfo>,
+    ) -> redis::RedisFuture<'a, Value> {
+        self.send_command_with_timeout(cmd, routing, None)
+    }
+
+    /// Sends a command like [`Client::send_command`]. When set, `request_timeout` replaces the client's request timeout
+    /// for this command. Blocking commands still derive their timeout from their arguments.
+    pub fn send_command_with_timeout<'a>(
+        &'a mut self,
+        cmd: &'a Cmd,
+        routing: Option<RoutingInfo>,
+        request_timeout: Option<Duration>,
     ) -> redis::RedisFuture<'a, Value> {
         let expected_type = expected_type_for_cmd(cmd);
-        let request_timeout = match get_request_timeout(cmd, self.request_timeout) {
+        let default_timeout = request_timeout.unwrap_or(self.request_timeout);
+        let request_timeout = match get_request_timeout(cmd, default_timeout) {
             Ok(request_timeout) => request_timeout,
             Err(err) => {
                 return async { Err(err) }.boxed();
@@ -336,7 +385,13 @@ impl Client {
         };
         run_with_timeout(request_timeout, async move {
             match self.internal_client {
+                ClientWrapper::Standalone(ref mut client) if get_selected_database(cmd).is_some() => {
+                    select_standalone_database(client, cmd).await
+                }
                 ClientWrapper::Standalone(ref mut client) => client.send_command(cmd).await,
+                ClientWrapper::Cluster { ref mut client } if get_selected_database(cmd).is_some() => {
+                    select_cluster_database(client, cmd).await
+                }
                 ClientWrapper::Cluster { ref mut client } => {
                     let routing =
                         if let Some(RoutingInfo::SingleNode(SingleNodeRoutingInfo::Random)) =
@@ -806,6 +861,7 @@ async fn create_cluster_client(
     if let Some(client_name) = redis_connection_info.client_name {
         builder = builder.client_name(client_name);
     }
+    builder = builder.database_id(redis_connection_info.db);
     if tls_mode != TlsMode::NoTls {
         let tls = if tls_mode == TlsMode::SecureTls {
             redis::cluster::TlsMode::Secure
@@ -394,6 +394,20 @@ impl ReconnectingConnection {
         client.update_password(new_password);
     }
 
+    /// Updates the database that's saved inside connection_info, that will be selected in case of disconnection from the
+    /// server. Returns the database previously saved.
+    pub(crate) fn update_connection_database(&self, database_id: i64) -> i64 {
+        let mut client = self
+            .inner
+            .backend
+            .connection_info
+            .write()
+            .expect(WRITE_LOCK_ERR);
+        let previous = client.get_connection_info().redis.db;
+        client.update_database(database_id);
+        previous
+    }
+
     /// Returns the username if one was configured during client creation. Otherwise, returns None.
     pub(crate) fn get_username(&self) -> Option<String> {
         let client = self.inner.backend.get_backend_client();
@@ -624,6 +624,16 @@ impl StandaloneClient {
         Ok(Value::Okay)
     }
 
+    /// Update the database selected by the connections to every node when they reconnect. Returns the database previously
+    /// selected.
+    pub fn update_connection_database(&self, database_id: i64) -> i64 {
+        let mut previous = database_id;
+        for node in self.inner.nodes.iter() {
+            previous = node.update_connection_database(database_id);
+        }
+        previous
+    }
+
     /// Retrieve the username used to authenticate with the server.
     pub fn get_username(&self) -> Option<String> {
         // All nodes in the client should have the same username configured, thus any connection would work here.
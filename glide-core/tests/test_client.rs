@@ -565,6 +565,98 @@ pub(crate) mod shared_client_tests {
         });
     }
 
+    /// Returns the CLIENT INFO of the connections of `client` to every node.
+    async fn get_client_infos(client: &mut Client, use_cluster: bool) -> Vec<String> {
+        let mut client_info_cmd = redis::Cmd::new();
+        client_info_cmd.arg("CLIENT").arg("INFO");
+        let value = client
+            .send_command(
+                &client_info_cmd,
+                Some(RoutingInfo::MultiNode((
+                    MultipleNodeRoutingInfo::AllNodes,
+                    None,
+                ))),
+            )
+            .await
+            .unwrap();
+        if use_cluster {
+            let client_infos: HashMap<String, String> =
+                redis::from_owned_redis_value(value).unwrap();
+            client_infos.into_values().collect()
+        } else {
+            vec![redis::from_owned_redis_value(value).unwrap()]
+        }
+    }
+
+    #[rstest]
+    #[serial_test::serial]
+    #[timeout(SHORT_STANDALONE_TEST_TIMEOUT)]
+    fn test_select_database_after_reconnection() {
+        block_on_all(async move {
+            let test_basics = setup_test_basics(
+                false,
+                TestConfiguration {
+                    shared_server: true,
+                    ..Default::default()
+                },
+            )
+            .await;
+            let mut client = test_basics.client;
+
+            let mut select_cmd = redis::cmd("SELECT");
+            select_cmd.arg(5);
+            let result = client.send_command(&select_cmd, None).await.unwrap();
+            assert_eq!(result, Value::Okay);
+
+            for i in 0..2 {
+                for client_info in get_client_infos(&mut client, false).await {
+                    assert!(client_info.contains("db=5"), "{client_info}");
+                }
+
+                if i == 0 {
+                    // first pass - kill the connections, which select the database again when they reconnect
+                    kill_connection(&mut client).await;
+                    // short sleep to allow the connection validation task to reconnect - 1s is enough since the detection should happen immediately
+                    tokio::time::sleep(std::time::Duration::from_secs(1)).await;
+                }
+            }
+        });
+    }
+
+    #[rstest]
+    #[serial_test::serial]
+    #[timeout(SHORT_CLUSTER_TEST_TIMEOUT)]
+    fn test_failed_select_keeps_database_after_reconnection(
+        #[values(false, true)] use_cluster: bool,
+    ) {
+        block_on_all(async move {
+            let test_basics = setup_test_basics(
+                use_cluster,
+                TestConfiguration {
+                    shared_server: true,
+                    ..Default::default()
+                },
+            )
+            .await;
+            let mut client = test_basics.client;
+
+            // The database doesn't exist, the connections stay on the default database.
+            let mut select_cmd = redis::cmd("SELECT");
+            select_cmd.arg(100000);
+            let result = client.send_command(&select_cmd, None).await;
+            assert!(result.is_err(), "{result:?}");
+
+            // The connections reconnect without selecting the database of the failed SELECT, which would fail them.
+            kill_connection(&mut client).await;
+            tokio::time::sleep(std::time::Duration::from_secs(1)).await;
+            for client_info in get_client_infos(&mut client, use_cluster).await {
+                assert!(client_info.contains("db=0"), "{client_info}");
+            }
+            let ping_result = client.send_command(&redis::cmd("PING"), None).await;
+            assert_eq!(ping_result, Ok(Value::SimpleString("PONG".to_string())));
+        });
+    }
+
     #[rstest]
     #[serial_test::serial]
     #[timeout(SHORT_CLUSTER_TEST_TIMEOUT)]
@@ -0,0 +1,30 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// Supports commands for the "ACL" subcommands of the "Server Management" group for a standalone or cluster client.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#server
+type AclBaseCommands interface {
+	AclSetUser(ctx context.Context, username string, rules *options.AclRules) (string, error)
+
+	AclDelUser(ctx context.Context, usernames []string) (int64, error)
+
+	AclCat(ctx context.Context) ([]string, error)
+
+	AclCatWithCategory(ctx context.Context, category string) ([]string, error)
+
+	AclGenPass(ctx context.Context) (string, error)
+
+	AclGenPassWithBits(ctx context.Context, bits int64) (string, error)
+
+	AclSave(ctx context.Context) (string, error)
+}
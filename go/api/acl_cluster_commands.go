@@ -0,0 +1,53 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// Supports commands for the "ACL" subcommands of the "Server Management" group for a cluster client. The users are
+// stored by every node, so the commands run on all nodes unless they are given another route.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#server
+type AclClusterCommands interface {
+	AclBaseCommands
+
+	AclGetUser(ctx context.Context, username string) (ClusterValue[Result[AclUser]], error)
+
+	AclGetUserWithRoute(ctx context.Context, username string, route options.RouteOption) (ClusterValue[Result[AclUser]], error)
+
+	AclList(ctx context.Context) (ClusterValue[[]string], error)
+
+	AclListWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[[]string], error)
+
+	AclUsers(ctx context.Context) (ClusterValue[[]string], error)
+
+	AclUsersWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[[]string], error)
+
+	AclWhoAmI(ctx context.Context) (ClusterValue[string], error)
+
+	AclWhoAmIWithRoute(ctx context.Context, route options.RouteOption) (ClusterValue[string], error)
+
+	AclDryRun(ctx context.Context, username string, command string, args []string) (ClusterValue[string], error)
+
+	AclDryRunWithRoute(
+		ctx context.Context,
+		username string,
+		command string,
+		args []string,
+		route options.RouteOption,
+	) (ClusterValue[string], error)
+
+	AclLoad(ctx context.Context) (string, error)
+
+	AclLog(ctx context.Context) (ClusterValue[[]AclLogEntry], error)
+
+	AclLogWithOptions(ctx context.Context, opts options.ClusterAclLogOptions) (ClusterValue[[]AclLogEntry], error)
+
+	AclLogReset(ctx context.Context) (string, error)
+}
@@ -0,0 +1,166 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+func TestAclRules_ToArgs(t *testing.T) {
+	hash := strings.Repeat("AB", 32)
+	rules := options.NewAclRules().
+		Reset().
+		On().
+		AddPassword("secret").
+		AddHashedPassword(hash).
+		AllowKeys("cache:*").
+		AllowReadKeys("shared:*").
+		AllowChannels("news.*").
+		AllowCategory("Read").
+		DenyCommand("KEYS").
+		AllowCommand("CONFIG|GET").
+		AddSelector(options.NewAclRules().AllowWriteKeys("logs:*").AllowCommand("xadd"))
+	args, err := rules.ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"reset", "on", ">secret", "#" + strings.ToLower(hash), "~cache:*", "%R~shared:*", "&news.*", "+@read", "-keys",
+		"+config|get", "(%W~logs:* +xadd)",
+	}, args)
+
+	args, err = (*options.AclRules)(nil).ToArgs()
+	assert.NoError(t, err)
+	assert.Empty(t, args)
+
+	_, err = options.NewAclRules().AddHashedPassword("not a hash").On().ToArgs()
+	assert.Error(t, err)
+	_, err = options.NewAclRules().AddSelector(options.NewAclRules().On()).ToArgs()
+	assert.Error(t, err)
+	_, err = options.NewAclRules().AddSelector(options.NewAclRules().RemoveHashedPassword("00")).ToArgs()
+	assert.Error(t, err)
+}
+
+func TestConvertAclUser(t *testing.T) {
+	user, err := convertAclUser(map[string]interface{}{
+		"flags":     []interface{}{"on", "sanitize-payload"},
+		"passwords": []interface{}{"2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b"},
+		"commands":  "-@all +get",
+		"keys":      "~cache:*",
+		"channels":  "",
+		"selectors": []interface{}{
+			map[string]interface{}{"commands": "-@all +set", "keys": "%W~logs:*", "channels": ""},
+		},
+	})
+	require.NoError(t, err)
+	assert.False(t, user.IsNil())
+	assert.Equal(t, AclUser{
+		Flags:     []string{"on", "sanitize-payload"},
+		Passwords: []string{"2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b"},
+		Commands:  "-@all +get",
+		Keys:      "~cache:*",
+		Selectors: []AclSelector{{Commands: "-@all +set", Keys: "%W~logs:*"}},
+	}, user.Value())
+
+	user, err = convertAclUser(nil)
+	assert.NoError(t, err)
+	assert.True(t, user.IsNil())
+
+	_, err = convertAclUser("user")
+	assert.Error(t, err)
+}
+
+func TestConvertAclLog(t *testing.T) {
+	entries, err := convertAclLog([]interface{}{
+		map[string]interface{}{
+			"count":                  int64(2),
+			"reason":                 "command",
+			"context":                "toplevel",
+			"object":                 "get",
+			"username":               "reader",
+			"age-seconds":            1.5,
+			"client-info":            "id=3 addr=127.0.0.1:50000",
+			"entry-id":               int64(7),
+			"timestamp-created":      int64(1700000000000),
+			"timestamp-last-updated": int64(1700000001000),
+		},
+		map[string]interface{}{"count": int64(1), "reason": "auth", "object": "AUTH", "age-seconds": "0.25"},
+	})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, AclLogEntry{
+		Count:                2,
+		Reason:               "command",
+		Context:              "toplevel",
+		Object:               "get",
+		Username:             "reader",
+		AgeSeconds:           1.5,
+		ClientInfo:           "id=3 addr=127.0.0.1:50000",
+		EntryId:              CreateInt64Result(7),
+		TimestampCreated:     CreateInt64Result(1700000000000),
+		TimestampLastUpdated: CreateInt64Result(1700000001000),
+	}, entries[0])
+	assert.Equal(t, 0.25, entries[1].AgeSeconds)
+	assert.True(t, entries[1].EntryId.IsNil())
+
+	entries, err = convertAclLog(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func ExampleGlideClient_AclSetUser() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	username := "user-" + uuid.NewString()
+	rules := options.NewAclRules().On().AddPassword("secret").AllowKeys("cache:*").AllowCategory("read")
+	result, err := client.AclSetUser(context.Background(), username, rules)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	user, err := client.AclGetUser(context.Background(), username)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	deleted, err := client.AclDelUser(context.Background(), []string{username})
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+	fmt.Println(user.Value().Keys)
+	fmt.Println(deleted)
+
+	// Output:
+	// OK
+	// ~cache:*
+	// 1
+}
+
+func ExampleGlideClient_AclWhoAmI() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	result, err := client.AclWhoAmI(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: default
+}
+
+func ExampleGlideClusterClient_AclWhoAmI() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.AclWhoAmI(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	for _, username := range result.MultiValue() {
+		fmt.Println(username)
+		break
+	}
+
+	// Output: default
+}
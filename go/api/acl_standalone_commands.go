@@ -0,0 +1,36 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// Supports commands for the "ACL" subcommands of the "Server Management" group for a standalone client.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#server
+type AclStandaloneCommands interface {
+	AclBaseCommands
+
+	AclGetUser(ctx context.Context, username string) (Result[AclUser], error)
+
+	AclList(ctx context.Context) ([]string, error)
+
+	AclUsers(ctx context.Context) ([]string, error)
+
+	AclWhoAmI(ctx context.Context) (string, error)
+
+	AclDryRun(ctx context.Context, username string, command string, args []string) (string, error)
+
+	AclLoad(ctx context.Context) (string, error)
+
+	AclLog(ctx context.Context) ([]AclLogEntry, error)
+
+	AclLogWithOptions(ctx context.Context, opts options.AclLogOptions) ([]AclLogEntry, error)
+
+	AclLogReset(ctx context.Context) (string, error)
+}
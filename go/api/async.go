@@ -0,0 +1,287 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"context"
+	goErrors "errors"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+	"github.com/valkey-io/valkey-glide/go/utils"
+)
+
+// Future is the result of a command sent with an [AsyncClient], which is available once the command completes.
+type Future[T any] struct {
+	done chan struct{}
+	// defaultValue is returned with the errors of the command, like the synchronous flavor of the command.
+	defaultValue T
+	value        T
+	err          error
+}
+
+// Done returns a channel which is closed once the command completes.
+func (future *Future[T]) Done() <-chan struct{} {
+	return future.done
+}
+
+// Await waits for the command to complete and returns its result. When ctx is done first, Await returns the error of ctx.
+// The command itself is only cancelled by the context it was sent with.
+func (future *Future[T]) Await(ctx context.Context) (T, error) {
+	select {
+	case <-future.done:
+		return future.value, future.err
+	case <-ctx.Done():
+		return future.defaultValue, contextError(ctx)
+	}
+}
+
+// AsyncClient sends commands without waiting for their response. Every command returns a [Future] completed by the callback
+// of the command, so that any number of commands can be in flight from a single goroutine. Use [BaseClient.Async] to get
+// the AsyncClient of a client.
+//
+// The commands are routed like the synchronous commands of the client, but they are sent once: they are neither retried
+// following the [RetryPolicy] of the client, nor hedged. When the context passed to a command is done before the command
+// completes, the command is cancelled and its Future completes with the error of the context.
+//
+// For example:
+//
+//	futures := make([]*api.Future[api.Result[string]], len(keys))
+//	for i, key := range keys {
+//	    futures[i] = client.Async().Get(ctx, key)
+//	}
+//	for _, future := range futures {
+//	    value, err := future.Await(ctx)
+//	    ...
+//	}
+type AsyncClient struct {
+	client *baseClient
+}
+
+// Async returns an [AsyncClient], sending commands through the client without waiting for their response.
+func (client *baseClient) Async() *AsyncClient {
+	return &AsyncClient{client}
+}
+
+// Get is the async flavor of [StringCommands.Get].
+func (async *AsyncClient) Get(ctx context.Context, key string) *Future[Result[string]] {
+	return sendAsync(async.client, ctx, C.Get, []string{key}, CreateNilStringResult(), handleStringOrNilResponse)
+}
+
+// Set is the async flavor of [StringCommands.Set].
+func (async *AsyncClient) Set(ctx context.Context, key string, value string) *Future[string] {
+	return sendAsync(async.client, ctx, C.Set, []string{key, value}, DefaultStringResponse, handleOkResponse)
+}
+
+// MGet is the async flavor of [StringCommands.MGet].
+func (async *AsyncClient) MGet(ctx context.Context, keys []string) *Future[[]Result[string]] {
+	return sendAsync(async.client, ctx, C.MGet, keys, nil, handleStringOrNilArrayResponse)
+}
+
+// MSet is the async flavor of [StringCommands.MSet].
+func (async *AsyncClient) MSet(ctx context.Context, keyValueMap map[string]string) *Future[string] {
+	return sendAsync(async.client, ctx, C.MSet, utils.MapToString(keyValueMap), DefaultStringResponse, handleOkResponse)
+}
+
+// Incr is the async flavor of [StringCommands.Incr].
+func (async *AsyncClient) Incr(ctx context.Context, key string) *Future[int64] {
+	return sendAsync(async.client, ctx, C.Incr, []string{key}, defaultIntResponse, handleIntResponse)
+}
+
+// IncrBy is the async flavor of [StringCommands.IncrBy].
+func (async *AsyncClient) IncrBy(ctx context.Context, key string, amount int64) *Future[int64] {
+	args := []string{key, utils.IntToString(amount)}
+	return sendAsync(async.client, ctx, C.IncrBy, args, defaultIntResponse, handleIntResponse)
+}
+
+// Del is the async flavor of [GenericBaseCommands.Del].
+func (async *AsyncClient) Del(ctx context.Context, keys []string) *Future[int64] {
+	return sendAsync(async.client, ctx, C.Del, keys, defaultIntResponse, handleIntResponse)
+}
+
+// Exists is the async flavor of [GenericBaseCommands.Exists].
+func (async *AsyncClient) Exists(ctx context.Context, keys []string) *Future[int64] {
+	return sendAsync(async.client, ctx, C.Exists, keys, defaultIntResponse, handleIntResponse)
+}
+
+// Expire is the async flavor of [GenericBaseCommands.Expire].
+func (async *AsyncClient) Expire(ctx context.Context, key string, seconds int64) *Future[bool] {
+	args := []string{key, utils.IntToString(seconds)}
+	return sendAsync(async.client, ctx, C.Expire, args, defaultBoolResponse, handleBoolResponse)
+}
+
+// HGet is the async flavor of [HashCommands.HGet].
+func (async *AsyncClient) HGet(ctx context.Context, key string, field string) *Future[Result[string]] {
+	return sendAsync(async.client, ctx, C.HGet, []string{key, field}, CreateNilStringResult(), handleStringOrNilResponse)
+}
+
+// HGetAll is the async flavor of [HashCommands.HGetAll].
+func (async *AsyncClient) HGetAll(ctx context.Context, key string) *Future[map[string]string] {
+	return sendAsync(async.client, ctx, C.HGetAll, []string{key}, nil, handleStringToStringMapResponse)
+}
+
+// HSet is the async flavor of [HashCommands.HSet].
+func (async *AsyncClient) HSet(ctx context.Context, key string, values map[string]string) *Future[int64] {
+	args := utils.ConvertMapToKeyValueStringArray(key, values)
+	return sendAsync(async.client, ctx, C.HSet, args, defaultIntResponse, handleIntResponse)
+}
+
+// LPush is the async flavor of [ListCommands.LPush].
+func (async *AsyncClient) LPush(ctx context.Context, key string, elements []string) *Future[int64] {
+	args := append([]string{key}, elements...)
+	return sendAsync(async.client, ctx, C.LPush, args, defaultIntResponse, handleIntResponse)
+}
+
+// RPush is the async flavor of [ListCommands.RPush].
+func (async *AsyncClient) RPush(ctx context.Context, key string, elements []string) *Future[int64] {
+	args := append([]string{key}, elements...)
+	return sendAsync(async.client, ctx, C.RPush, args, defaultIntResponse, handleIntResponse)
+}
+
+// LPop is the async flavor of [ListCommands.LPop].
+func (async *AsyncClient) LPop(ctx context.Context, key string) *Future[Result[string]] {
+	return sendAsync(async.client, ctx, C.LPop, []string{key}, CreateNilStringResult(), handleStringOrNilResponse)
+}
+
+// SAdd is the async flavor of [SetCommands.SAdd].
+func (async *AsyncClient) SAdd(ctx context.Context, key string, members []string) *Future[int64] {
+	args := append([]string{key}, members...)
+	return sendAsync(async.client, ctx, C.SAdd, args, defaultIntResponse, handleIntResponse)
+}
+
+// SIsMember is the async flavor of [SetCommands.SIsMember].
+func (async *AsyncClient) SIsMember(ctx context.Context, key string, member string) *Future[bool] {
+	return sendAsync(async.client, ctx, C.SIsMember, []string{key, member}, defaultBoolResponse, handleBoolResponse)
+}
+
+// ZAdd is the async flavor of [SortedSetCommands.ZAdd].
+func (async *AsyncClient) ZAdd(ctx context.Context, key string, membersScoreMap map[string]float64) *Future[int64] {
+	args := append([]string{key}, utils.ConvertMapToValueKeyStringArray(membersScoreMap)...)
+	return sendAsync(async.client, ctx, C.ZAdd, args, defaultIntResponse, handleIntResponse)
+}
+
+// ZScore is the async flavor of [SortedSetCommands.ZScore].
+func (async *AsyncClient) ZScore(ctx context.Context, key string, member string) *Future[Result[float64]] {
+	return sendAsync(async.client, ctx, C.ZScore, []string{key, member}, CreateNilFloat64Result(), handleFloatOrNilResponse)
+}
+
+// sendAsync sends the command without waiting for its response, which is converted by handle once received.
+func sendAsync[T any](
+	client *baseClient,
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	defaultValue T,
+	handle func(*C.struct_CommandResponse) (T, error),
+) *Future[T] {
+	future := &Future[T]{done: make(chan struct{}), defaultValue: defaultValue}
+	client.submitAsync(ctx, requestType, args, func(result payload) {
+		if result.error != nil {
+			future.value, future.err = defaultValue, result.error
+		} else {
+			future.value, future.err = handle(result.value)
+		}
+		close(future.done)
+	})
+	return future
+}
+
+// submitAsync sends a single attempt of the command without waiting for its response, and hands its result to complete.
+// Like sendCommand, the command is routed following the ReadFrom strategy of ctx, goes through the circuit breaker, and is
+// spread between the connections of the client.
+func (client *baseClient) submitAsync(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	complete func(payload),
+) {
+	if client.shards != nil {
+		shard, err := client.shards.shardFor(protobuf.RequestType(requestType), args)
+		if err != nil {
+			complete(payload{error: err})
+			return
+		}
+		shard.submitAsync(ctx, requestType, args, complete)
+		return
+	}
+	if err := ctx.Err(); err != nil {
+		complete(payload{error: err})
+		return
+	}
+	route, err := client.readFromRoute(ctx, protobuf.RequestType(requestType), args, nil)
+	if err != nil {
+		complete(payload{error: err})
+		return
+	}
+	if client.circuitBreaker != nil {
+		if node := client.circuitBreaker.nodeFor(protobuf.RequestType(requestType), args, route); node != "" {
+			done, err := client.circuitBreaker.acquire(node)
+			if err != nil {
+				complete(payload{error: err})
+				return
+			}
+			start, completeCommand := time.Now(), complete
+			complete = func(result payload) {
+				done(time.Since(start), result.error)
+				completeCommand(result)
+			}
+		}
+	}
+	if err := client.injectFault(ctx, requestType, args); err != nil {
+		complete(payload{error: err})
+		return
+	}
+
+	connection := client
+	if client.connections != nil {
+		index := client.connections.acquire()
+		if index > 0 {
+			connection = client.connections.extra[index-1]
+		}
+		completeCommand := complete
+		complete = func(result payload) {
+			client.connections.release(index)
+			completeCommand(result)
+		}
+	}
+	connection.startAsync(ctx, requestType, args, route, complete)
+}
+
+// startAsync sends the command to the core client of the client, and hands its result to complete once received. The
+// command is cancelled in the core when ctx is done first.
+func (client *baseClient) startAsync(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+	complete func(payload),
+) {
+	request := &pendingRequest{client: client}
+	stop := context.AfterFunc(ctx, func() {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		// The request is registered from the time it's sent until it completes.
+		if request.id != 0 && pendingRequests.contains(request.id) {
+			C.cancel_command(request.core, C.uintptr_t(request.id))
+		}
+	})
+	// The result is delivered once, by the first of the callback of the request and the closing of the client.
+	request.complete = func(result payload) {
+		stop()
+		var timeoutErr *errors.TimeoutError
+		if result.error != nil &&
+			(ctx.Err() != nil || goErrors.As(result.error, &timeoutErr) && deadlineExceeded(ctx)) {
+			result.error = contextError(ctx)
+		}
+		complete(result)
+	}
+
+	if err := client.startCommand(ctx, request, requestType, args, route); err != nil {
+		request.complete(payload{error: err})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func TestFuture_Await(t *testing.T) {
+	future := &Future[int64]{done: make(chan struct{}), defaultValue: -1}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	value, err := future.Await(ctx)
+	assert.Equal(t, int64(-1), value)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	select {
+	case <-future.Done():
+		assert.Fail(t, "the future isn't completed")
+	default:
+	}
+
+	future.value = 42
+	close(future.done)
+	value, err = future.Await(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), value)
+}
+
+func TestAsyncClient_CompletesWithoutCore(t *testing.T) {
+	client := &baseClient{}
+
+	result, err := client.Async().Get(context.Background(), "key").Await(context.Background())
+	assert.IsType(t, &errors.ClosingError{}, err)
+	assert.True(t, result.IsNil())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = client.Async().Incr(ctx, "key").Await(context.Background())
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -12,8 +12,8 @@ package api
 // #cgo darwin,amd64 LDFLAGS: -L${SRCDIR}/../rustbin/x86_64-apple-darwin
 // #include "../lib.h"
 //
-// void successCallback(void *channelPtr, struct CommandResponse *message);
-// void failureCallback(void *channelPtr, char *errMessage, RequestErrorType errType);
+// void successCallback(uintptr_t id, struct CommandResponse *message);
+// void failureCallback(uintptr_t id, char *errMessage, RequestErrorType errType);
 // void pubSubCallback(void *clientPtr, enum PushKind kind,
 //                     const uint8_t *message, int64_t message_len,
 //                     const uint8_t *channel, int64_t channel_len,
@@ -22,14 +22,18 @@ import "C"
 
 import (
 	"context"
+	goErrors "errors"
 	"fmt"
 	"math"
 	"strconv"
 	"sync"
+	"sync/atomic"
+	"time"
 	"unsafe"
 
 	"github.com/valkey-io/valkey-glide/go/api/config"
 	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/internal/faulthook"
 	"github.com/valkey-io/valkey-glide/go/api/options"
 	"github.com/valkey-io/valkey-glide/go/protobuf"
 	"github.com/valkey-io/valkey-glide/go/utils"
@@ -51,6 +55,10 @@ type BaseClient interface {
 	ScriptingAndFunctionBaseCommands
 	PubSubCommands
 	PubSubHandler
+	// Async returns an [AsyncClient], sending commands through the client without waiting for their response.
+	Async() *AsyncClient
+	// FireAndForget returns a [FireAndForgetClient], sending commands through the client without receiving their response.
+	FireAndForget() *FireAndForgetClient
 	// Close terminates the client by closing all associated resources.
 	Close()
 }
@@ -67,10 +75,43 @@ type clientConfiguration interface {
 }
 
 type baseClient struct {
-	pending        map[unsafe.Pointer]struct{}
 	coreClient     unsafe.Pointer
 	mu             sync.Mutex
 	messageHandler *MessageHandler
+	// faultHook evaluates the commands before they are sent, when the client is wrapped by package faults.
+	faultHook      atomic.Pointer[faulthook.Hook]
+	retryPolicy    *RetryPolicy
+	circuitBreaker *circuitBreaker
+	hedger         *hedger
+	clusterSlots   *clusterSlots
+	latencies      *latencyTracker
+	nodeZones      *nodeZones
+	readFrom       ReadFrom
+	clientAZ       string
+	// connectionConfig configures the separate connections opened by the client: unblockClient, which unblocks the server
+	// side of cancelled blocking commands, and the dedicated connections.
+	connectionConfig clientConfiguration
+	unblockMu        sync.Mutex
+	unblockClient    *baseClient
+	// readFromConfig configures the clients serving the read-only commands of a standalone client executed with a
+	// [ReadFrom] override, which are kept in readFromClients.
+	readFromConfig  func(readFrom ReadFrom) clientConfiguration
+	readFromMu      sync.Mutex
+	readFromClients map[ReadFrom]*baseClient
+	dedicated       *dedicatedPool
+	// connections holds the connections opened in addition to the connection of the client, when configured with more than
+	// one connection per node.
+	connections *connections
+	// database is the database selected by the client, which the separate connections opened later connect to.
+	database atomic.Int64
+	// fireAndForget tracks the commands sent with the FireAndForgetClient of the client.
+	fireAndForget fireAndForgetState
+	// fireAndForgetErrorHandler receives the errors of the commands sent with the FireAndForgetClient of the client.
+	fireAndForgetErrorHandler func(error)
+	// closed is closed when the client is closed, to stop its background tasks.
+	closed chan struct{}
+	// shards routes the commands of a ShardedClient to the clients of its nodes. The client has no core client of its own.
+	shards *shardSet
 }
 
 // setMessageHandler assigns a message handler to the client for processing pub/sub messages
@@ -148,6 +189,18 @@ func buildAsyncClientType(successCb C.SuccessCallback, failureCb C.FailureCallba
 // Once the connection is established, this function invokes `free_connection_response` exposed by rust library to free the
 // connection_response to avoid any memory leaks.
 func createClient(config clientConfiguration) (*baseClient, error) {
+	core, err := connectCore(config)
+	if err != nil {
+		return nil, err
+	}
+	client := &baseClient{coreClient: core, closed: make(chan struct{})}
+	// Register the client in our registry using the pointer value from C
+	registerClient(client, uintptr(core))
+	return client, nil
+}
+
+// connectCore connects a core client configured by config.
+func connectCore(config clientConfiguration) (unsafe.Pointer, error) {
 	request, err := config.toProtobuf()
 	if err != nil {
 		return nil, err
@@ -167,7 +220,6 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 	if err != nil {
 		return nil, &errors.ClosingError{Msg: err.Error()}
 	}
-	client := &baseClient{pending: make(map[unsafe.Pointer]struct{})}
 
 	cResponse := (*C.struct_ConnectionResponse)(
 		C.create_client(
@@ -183,21 +235,53 @@ func createClient(config clientConfiguration) (*baseClient, error) {
 		message := C.GoString(cErr)
 		return nil, &errors.ConnectionError{Msg: message}
 	}
+	return cResponse.conn_ptr, nil
+}
+
+// replaceCore connects a new core client configured by config, with which the following commands of the client are sent.
+// Returns the previous core client, which keeps completing the commands already sent with it until retired with
+// retireCore.
+func (client *baseClient) replaceCore(config clientConfiguration) (unsafe.Pointer, error) {
+	core, err := connectCore(config)
+	if err != nil {
+		return nil, err
+	}
+	client.mu.Lock()
+	defer client.mu.Unlock()
+	if client.coreClient == nil {
+		C.close_client(core)
+		return nil, &errors.ClosingError{Msg: "The client is closed."}
+	}
+	previous := client.coreClient
+	client.coreClient = core
+	registerClient(client, uintptr(core))
+	return previous, nil
+}
 
-	client.coreClient = cResponse.conn_ptr
+// retireCore closes a core client replaced with replaceCore, and fails the commands sent with it which didn't complete.
+func (client *baseClient) retireCore(core unsafe.Pointer) {
+	client.mu.Lock()
+	unregisterClient(uintptr(core))
+	C.close_client(core)
+	pending := pendingRequests.claimCore(core)
+	client.mu.Unlock()
 
-	// Register the client in our registry using the pointer value from C
-	registerClient(client, uintptr(cResponse.conn_ptr))
+	for _, request := range pending {
+		request.deliver(payload{value: nil, error: errors.NewDisconnectError("The connection was closed after a failover.")})
+	}
+}
 
-	return client, nil
+// registerRequest registers request as sent with the current core client. Must be called with the lock held.
+func (client *baseClient) registerRequest(request *pendingRequest) {
+	request.core = client.coreClient
+	pendingRequests.register(request)
 }
 
 // Close terminates the client by closing all associated resources.
 func (client *baseClient) Close() {
 	client.mu.Lock()
-	defer client.mu.Unlock()
-
 	if client.coreClient == nil {
+		client.mu.Unlock()
 		return
 	}
 
@@ -205,14 +289,35 @@ func (client *baseClient) Close() {
 
 	C.close_client(client.coreClient)
 	client.coreClient = nil
+	// The core doesn't invoke callbacks once closed.
+	client.fireAndForget.close()
+	close(client.closed)
+	client.unblockMu.Lock()
+	if client.unblockClient != nil {
+		client.unblockClient.Close()
+	}
+	client.unblockMu.Unlock()
+	client.readFromMu.Lock()
+	for _, readFromClient := range client.readFromClients {
+		readFromClient.Close()
+	}
+	client.readFromMu.Unlock()
+	if client.dedicated != nil {
+		client.dedicated.close()
+	}
+	if client.connections != nil {
+		client.connections.close()
+	}
 
-	// iterating the channel map while holding the lock guarantees those unsafe.Pointers is still valid
-	// because holding the lock guarantees the owner of the unsafe.Pointer hasn't exit.
-	for channelPtr := range client.pending {
-		resultChannel := *(*chan payload)(channelPtr)
-		resultChannel <- payload{value: nil, error: &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}}
+	pending := pendingRequests.claimClient(client)
+	client.mu.Unlock()
+
+	// The pending requests are completed without holding the lock, which is taken by the completion of async requests.
+	for _, request := range pending {
+		request.deliver(
+			payload{value: nil, error: &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}},
+		)
 	}
-	client.pending = nil
 }
 
 func (client *baseClient) executeCommand(
@@ -302,6 +407,145 @@ func (client *baseClient) executeCommandWithRoute(
 	requestType C.RequestType,
 	args []string,
 	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if client.shards != nil {
+		shard, err := client.shards.shardFor(protobuf.RequestType(requestType), args)
+		if err != nil {
+			return nil, err
+		}
+		return shard.executeCommandWithRoute(ctx, requestType, args, route)
+	}
+	policy := client.retryPolicy
+	if policy == nil || !policy.appliesTo(ctx, protobuf.RequestType(requestType)) {
+		return client.executeAttempt(ctx, requestType, args, route)
+	}
+
+	return retry(ctx, policy, func() (*C.struct_CommandResponse, error) {
+		return client.executeAttempt(ctx, requestType, args, route)
+	})
+}
+
+// executeAttempt executes a single attempt of the command, hedging it when the client is configured for hedged reads.
+func (client *baseClient) executeAttempt(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if client.hedger != nil {
+		if _, ok := idempotentRequestTypes[protobuf.RequestType(requestType)]; ok {
+			return client.sendHedged(ctx, requestType, args, route)
+		}
+	}
+	return client.sendCommand(ctx, requestType, args, route)
+}
+
+// sendHedged sends the command, and sends it a second time if it hasn't completed within the hedging delay. The first
+// successful response is returned and the other request is cancelled.
+func (client *baseClient) sendHedged(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	client.hedger.deposit()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	firstRoute, hedgeRoute := client.hedgeRoutes(ctx, protobuf.RequestType(requestType), args, route)
+	// The channel is buffered, so that the request which loses the race doesn't block.
+	results := make(chan payload, 2)
+	send := func(route config.Route) {
+		response, err := client.sendCommand(ctx, requestType, args, route)
+		results <- payload{value: response, error: err}
+	}
+
+	start := time.Now()
+	go send(firstRoute)
+	inFlight := 1
+	timer := time.NewTimer(client.hedger.delay())
+	defer timer.Stop()
+	for {
+		select {
+		case result := <-results:
+			inFlight--
+			if result.error != nil && inFlight > 0 {
+				// Wait for the other request, which may still succeed.
+				continue
+			}
+			if result.error == nil {
+				client.hedger.observe(time.Since(start))
+			}
+			if inFlight > 0 {
+				go func() {
+					if loser := <-results; loser.value != nil {
+						C.free_command_response(loser.value)
+					}
+				}()
+			}
+			return result.value, result.error
+		case <-timer.C:
+			if client.hedger.withdraw() {
+				inFlight++
+				go send(hedgeRoute)
+			}
+		}
+	}
+}
+
+// sendCommand sends a single attempt of the command, unless the circuit breaker of the node serving it is open.
+func (client *baseClient) sendCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	readFromClient, err := client.readFromOverrideClient(ctx, protobuf.RequestType(requestType))
+	if err != nil {
+		return nil, err
+	}
+	if readFromClient != nil {
+		return readFromClient.sendCommand(ctx, requestType, args, route)
+	}
+	route, err = client.readFromRoute(ctx, protobuf.RequestType(requestType), args, route)
+	if err != nil {
+		return nil, err
+	}
+	if client.circuitBreaker != nil {
+		if node := client.circuitBreaker.nodeFor(protobuf.RequestType(requestType), args, route); node != "" {
+			done, err := client.circuitBreaker.acquire(node)
+			if err != nil {
+				return nil, err
+			}
+			start := time.Now()
+			response, err := client.dispatchCommand(ctx, requestType, args, route)
+			done(time.Since(start), err)
+			return response, err
+		}
+	}
+	return client.dispatchCommand(ctx, requestType, args, route)
+}
+
+// dispatchCommand sends a single attempt of the command, unblocking the server side of blocking commands when ctx is done
+// first.
+func (client *baseClient) dispatchCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if key, ok := blockingCommandKey(protobuf.RequestType(requestType), args); ok && client.connectionConfig != nil {
+		return client.sendBlockingCommand(ctx, requestType, args, route, key)
+	}
+	return client.sendCommandToCore(ctx, requestType, args, route)
+}
+
+// sendCommandToCore sends a single attempt of the command to the core and waits for its response.
+func (client *baseClient) sendCommandToCore(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
 ) (*C.struct_CommandResponse, error) {
 	// Check if context is already done
 	select {
@@ -311,82 +555,143 @@ func (client *baseClient) executeCommandWithRoute(
 		// Continue with execution
 	}
 
-	var cArgsPtr *C.uintptr_t = nil
-	var argLengthsPtr *C.ulong = nil
-	if len(args) > 0 {
-		cArgs, argLengths := toCStrings(args)
-		cArgsPtr = &cArgs[0]
-		argLengthsPtr = &argLengths[0]
+	if err := client.injectFault(ctx, requestType, args); err != nil {
+		return nil, err
 	}
 
-	var routeBytesPtr *C.uchar = nil
-	var routeBytesCount C.uintptr_t = 0
-	if route != nil {
-		routeProto, err := routeToProtobuf(route)
-		if err != nil {
-			return nil, &errors.RequestError{Msg: "ExecuteCommand failed due to invalid route"}
-		}
-		msg, err := proto.Marshal(routeProto)
-		if err != nil {
-			return nil, err
-		}
-
-		routeBytesCount = C.uintptr_t(len(msg))
-		routeBytesPtr = (*C.uchar)(C.CBytes(msg))
+	if client.connections != nil {
+		return client.connections.send(ctx, client, requestType, args, route)
 	}
+	return client.submitCommand(ctx, requestType, args, route)
+}
 
-	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
-	resultChannel := make(chan payload, 1)
-	resultChannelPtr := unsafe.Pointer(&resultChannel)
+// submitCommand submits the command to the core client of the client and waits for its response.
+func (client *baseClient) submitCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	request := newPendingRequest(client)
+	if err := client.startCommand(ctx, request, requestType, args, route); err != nil {
+		request.free()
+		return nil, err
+	}
+	return client.waitForResponse(ctx, request)
+}
 
-	pinner := pinner{}
-	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
-	defer pinner.Unpin()
+// startCommand sends the command to the core client of the client, with request receiving the response of the command.
+// The request is registered in pendingRequests and can be cancelled in the core, unless it's a shared request, which is
+// registered by its owner. The commands of a shared request share the ID the core cancels requests by, so they can't be
+// cancelled one by one.
+func (client *baseClient) startCommand(
+	ctx context.Context,
+	request *pendingRequest,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) error {
+	buffers := commandBuffersPool.Get().(*commandBuffers)
+	defer buffers.free()
+	cArgsPtr, argLengthsPtr := buffers.setArgs(args)
+	routeBytesPtr, routeBytesCount, err := buffers.setRoute(route)
+	if err != nil {
+		return err
+	}
 
 	client.mu.Lock()
+	defer client.mu.Unlock()
 	if client.coreClient == nil {
-		client.mu.Unlock()
-		return nil, &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}
+		return &errors.ClosingError{Msg: "ExecuteCommand failed. The client is closed."}
+	}
+	// Checked while holding the lock, since the requests of a done ctx are only cancelled once pending.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !request.shared {
+		client.registerRequest(request)
 	}
-	client.pending[resultChannelPtr] = struct{}{}
 	C.command(
 		client.coreClient,
-		C.uintptr_t(pinnedChannelPtr),
+		C.uintptr_t(request.id),
 		uint32(requestType),
 		C.size_t(len(args)),
 		cArgsPtr,
 		argLengthsPtr,
 		routeBytesPtr,
 		routeBytesCount,
+		C.uint32_t(deadlineTimeout(ctx)),
+		C._Bool(!request.shared),
 	)
-	client.mu.Unlock()
+	return nil
+}
+
+// deadlineTimeout returns the time left until the deadline of ctx in milliseconds, rounded up, which the core uses as the
+// request timeout instead of the client's one. Returns 0 when ctx has no deadline.
+func deadlineTimeout(ctx context.Context) uint32 {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0
+	}
+	millis := (time.Until(deadline) + time.Millisecond - 1) / time.Millisecond
+	return uint32(min(max(millis, 1), math.MaxUint32))
+}
 
-	// Wait for result or context cancellation
+// waitForResponse waits for the response of the core request sent with request, and frees the request. When ctx is done
+// first, the request is cancelled in the core and the error of ctx is returned.
+func (client *baseClient) waitForResponse(
+	ctx context.Context,
+	request *pendingRequest,
+) (*C.struct_CommandResponse, error) {
 	var payload payload
+	cancelled := false
 	select {
+	case payload = <-request.result:
 	case <-ctx.Done():
+		cancelled = true
 		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
+		// The request is cancelled with the core client it was sent with, which is open while the request is pending.
+		if pendingRequests.contains(request.id) {
+			C.cancel_command(request.core, C.uintptr_t(request.id))
 		}
 		client.mu.Unlock()
-		return nil, ctx.Err()
-	case payload = <-resultChannel:
-		// Continue with normal processing
+		// The core invokes the callback of a cancelled request right away. Waiting for it lets us reuse the request, and
+		// free the response of a request which completed concurrently.
+		payload = <-request.result
 	}
+	request.free()
 
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
+	if cancelled {
+		if payload.value != nil {
+			C.free_command_response(payload.value)
+		}
+		return nil, contextError(ctx)
 	}
-	client.mu.Unlock()
-
 	if payload.error != nil {
+		var timeoutErr *errors.TimeoutError
+		if goErrors.As(payload.error, &timeoutErr) && deadlineExceeded(ctx) {
+			return nil, contextError(ctx)
+		}
 		return nil, payload.error
 	}
 	return payload.value, nil
 }
 
+// deadlineExceeded reports whether the deadline of ctx has passed, which may happen slightly before ctx is done.
+func deadlineExceeded(ctx context.Context) bool {
+	deadline, ok := ctx.Deadline()
+	return ok && !time.Now().Before(deadline)
+}
+
+// contextError returns the error of a request interrupted by ctx. A request which didn't complete before the deadline of
+// ctx fails with a [errors.TimeoutError] wrapping [context.DeadlineExceeded].
+func contextError(ctx context.Context) error {
+	if deadlineExceeded(ctx) {
+		return errors.WrapTimeoutError("Request timed out: "+context.DeadlineExceeded.Error(), context.DeadlineExceeded)
+	}
+	return ctx.Err()
+}
+
 // Zero copying conversion from go's []string into C pointers
 func toCStrings(args []string) ([]C.uintptr_t, []C.ulong) {
 	cStrings := make([]C.uintptr_t, len(args))
@@ -403,6 +708,67 @@ func toCStrings(args []string) ([]C.uintptr_t, []C.ulong) {
 	return cStrings, stringLengths
 }
 
+// maxPooledArgs is the number of arguments above which the buffers of a command aren't kept for reuse, so that a single
+// large command doesn't keep large buffers alive.
+const maxPooledArgs = 1024
+
+// commandBuffers holds the C arrays of the arguments of a command, and the protobuf of its route, passed to the core.
+// The core copies them before [C.command] returns, so the buffers are reused between commands through commandBuffersPool.
+type commandBuffers struct {
+	args    []C.uintptr_t
+	lengths []C.ulong
+	route   []byte
+}
+
+var commandBuffersPool = sync.Pool{New: func() any { return &commandBuffers{} }}
+
+// setArgs fills the buffers with the pointers to args and their lengths, without copying args, and returns the pointers
+// to pass to the core.
+func (buffers *commandBuffers) setArgs(args []string) (*C.uintptr_t, *C.ulong) {
+	if len(args) == 0 {
+		return nil, nil
+	}
+	buffers.args = buffers.args[:0]
+	buffers.lengths = buffers.lengths[:0]
+	for _, arg := range args {
+		var ptr uintptr
+		if len(arg) > 0 {
+			ptr = uintptr(unsafe.Pointer(unsafe.StringData(arg)))
+		}
+		buffers.args = append(buffers.args, C.uintptr_t(ptr))
+		buffers.lengths = append(buffers.lengths, C.ulong(len(arg)))
+	}
+	return &buffers.args[0], &buffers.lengths[0]
+}
+
+// setRoute serializes route into the buffers, and returns the pointer to pass to the core and its length. Returns a nil
+// pointer when route is nil.
+func (buffers *commandBuffers) setRoute(route config.Route) (*C.uchar, C.uintptr_t, error) {
+	if route == nil {
+		return nil, 0, nil
+	}
+	routeProto, err := routeToProtobuf(route)
+	if err != nil {
+		return nil, 0, &errors.RequestError{Msg: "ExecuteCommand failed due to invalid route"}
+	}
+	buffers.route, err = proto.MarshalOptions{}.MarshalAppend(buffers.route[:0], routeProto)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(buffers.route) == 0 {
+		return nil, 0, nil
+	}
+	return (*C.uchar)(unsafe.Pointer(&buffers.route[0])), C.uintptr_t(len(buffers.route)), nil
+}
+
+// free returns the buffers to commandBuffersPool.
+func (buffers *commandBuffers) free() {
+	if cap(buffers.args) > maxPooledArgs {
+		return
+	}
+	commandBuffersPool.Put(buffers)
+}
+
 func (client *baseClient) submitConnectionPasswordUpdate(
 	ctx context.Context,
 	password string,
@@ -416,54 +782,29 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 		// Continue with execution
 	}
 
-	// Create a channel to receive the result
-	resultChannel := make(chan payload, 1)
-	resultChannelPtr := unsafe.Pointer(&resultChannel)
-
-	pinner := pinner{}
-	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
-	defer pinner.Unpin()
+	request := newPendingRequest(client)
 
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
+		request.free()
 		return DefaultStringResponse, &errors.ClosingError{Msg: "UpdatePassword failed. The client is closed."}
 	}
-	client.pending[resultChannelPtr] = struct{}{}
+	client.registerRequest(request)
 
 	C.update_connection_password(
 		client.coreClient,
-		C.uintptr_t(pinnedChannelPtr),
+		C.uintptr_t(request.id),
 		C.CString(password),
 		C._Bool(immediateAuth),
 	)
 	client.mu.Unlock()
 
-	// Wait for result or context cancellation
-	var payload payload
-	select {
-	case <-ctx.Done():
-		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
-		}
-		client.mu.Unlock()
-		return DefaultStringResponse, ctx.Err()
-	case payload = <-resultChannel:
-		// Continue with normal processing
-	}
-
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
-
-	if payload.error != nil {
-		return DefaultStringResponse, payload.error
+	response, err := client.waitForResponse(ctx, request)
+	if err != nil {
+		return DefaultStringResponse, err
 	}
-
-	return handleOkResponse(payload.value)
+	return handleOkResponse(response)
 }
 
 // Update the current connection with a new password.
@@ -491,7 +832,7 @@ func (client *baseClient) submitConnectionPasswordUpdate(
 //
 //	`"OK"` response on success.
 func (client *baseClient) UpdateConnectionPassword(ctx context.Context, password string, immediateAuth bool) (string, error) {
-	return client.submitConnectionPasswordUpdate(ctx, password, immediateAuth)
+	return client.updateConnectionPasswords(ctx, password, immediateAuth)
 }
 
 // Update the current connection by removing the password.
@@ -515,7 +856,38 @@ func (client *baseClient) UpdateConnectionPassword(ctx context.Context, password
 //
 //	`"OK"` response on success.
 func (client *baseClient) ResetConnectionPassword(ctx context.Context) (string, error) {
-	return client.submitConnectionPasswordUpdate(ctx, "", false)
+	return client.updateConnectionPasswords(ctx, "", false)
+}
+
+// updateConnectionPasswords updates the password of the connection of the client, and of the separate connections it
+// opened: the extra connections, the connection unblocking cancelled blocking commands, the idle dedicated connections
+// and the connections serving ReadFrom overrides.
+func (client *baseClient) updateConnectionPasswords(ctx context.Context, password string, immediateAuth bool) (string, error) {
+	var connections []*baseClient
+	if client.connections != nil {
+		connections = append(connections, client.connections.extra...)
+	}
+	client.unblockMu.Lock()
+	if client.unblockClient != nil {
+		connections = append(connections, client.unblockClient)
+	}
+	client.unblockMu.Unlock()
+	client.readFromMu.Lock()
+	for _, readFromClient := range client.readFromClients {
+		connections = append(connections, readFromClient)
+	}
+	client.readFromMu.Unlock()
+	for _, connection := range connections {
+		if _, err := connection.submitConnectionPasswordUpdate(ctx, password, immediateAuth); err != nil {
+			return DefaultStringResponse, err
+		}
+	}
+	if client.dedicated != nil {
+		if err := client.dedicated.updatePassword(ctx, password, immediateAuth); err != nil {
+			return DefaultStringResponse, err
+		}
+	}
+	return client.submitConnectionPasswordUpdate(ctx, password, immediateAuth)
 }
 
 // Set the given key with the given value. The return value is a response from Valkey containing the string "OK".
@@ -8606,6 +8978,13 @@ func (client *baseClient) executeScriptWithRoute(
 	args []string,
 	route config.Route,
 ) (*C.struct_CommandResponse, error) {
+	if client.shards != nil {
+		shard, err := client.shards.shardForKeys(keys)
+		if err != nil {
+			return nil, err
+		}
+		return shard.executeScriptWithRoute(ctx, hash, keys, args, route)
+	}
 	// Check if context is already done
 	select {
 	case <-ctx.Done():
@@ -8645,23 +9024,18 @@ func (client *baseClient) executeScriptWithRoute(
 		routeBytesPtr = (*C.uchar)(C.CBytes(msg))
 	}
 
-	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
-	resultChannel := make(chan payload, 1)
-	resultChannelPtr := unsafe.Pointer(&resultChannel)
-
-	pinner := pinner{}
-	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
-	defer pinner.Unpin()
+	request := newPendingRequest(client)
 
 	client.mu.Lock()
 	if client.coreClient == nil {
 		client.mu.Unlock()
+		request.free()
 		return nil, &errors.ClosingError{Msg: "ExecuteScript failed. The client is closed."}
 	}
-	client.pending[resultChannelPtr] = struct{}{}
+	client.registerRequest(request)
 	C.invoke_script(
 		client.coreClient,
-		C.uintptr_t(pinnedChannelPtr),
+		C.uintptr_t(request.id),
 		C.CString(hash),
 		C.size_t(len(keys)),
 		cKeysPtr,
@@ -8674,30 +9048,7 @@ func (client *baseClient) executeScriptWithRoute(
 	)
 	client.mu.Unlock()
 
-	// Wait for result or context cancellation
-	var payload payload
-	select {
-	case <-ctx.Done():
-		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
-		}
-		client.mu.Unlock()
-		return nil, ctx.Err()
-	case payload = <-resultChannel:
-		// Continue with normal processing
-	}
-
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
-
-	if payload.error != nil {
-		return nil, payload.error
-	}
-	return payload.value, nil
+	return client.waitForResponse(ctx, request)
 }
 
 // Checks existence of scripts in the script cache by their SHA1 digest.
@@ -8815,3 +9166,225 @@ func (client *baseClient) ScriptKill(ctx context.Context) (string, error) {
 	}
 	return handleOkResponse(result)
 }
+
+// Creates a user, or modifies the rules of an existing user.
+//
+// Note:
+//
+//	When in cluster mode, this command will be routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//	rules - The rules to apply to the user, in order. See [options.AclRules].
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-setuser/
+func (client *baseClient) AclSetUser(ctx context.Context, username string, rules *options.AclRules) (string, error) {
+	args, err := rules.ToArgs()
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	result, err := client.executeCommand(ctx, C.AclSetSser, append([]string{username}, args...))
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Deletes users, and terminates the connections authenticated as them.
+//
+// Note:
+//
+//	When in cluster mode, this command will be routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	usernames - The names of the users to delete.
+//
+// Return value:
+//
+//	The number of users that were deleted. Users that don't exist are ignored.
+//
+// [valkey.io]: https://valkey.io/commands/acl-deluser/
+func (client *baseClient) AclDelUser(ctx context.Context, usernames []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.AclDelUser, usernames)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Lists the categories of commands, which can be allowed or denied to users.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array of category names.
+//
+// [valkey.io]: https://valkey.io/commands/acl-cat/
+func (client *baseClient) AclCat(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclCat, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Lists the commands of a category.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	category - The name of the category, as returned by [AclCat].
+//
+// Return value:
+//
+//	An array of command names. Subcommands are named as "command|subcommand".
+//
+// [valkey.io]: https://valkey.io/commands/acl-cat/
+func (client *baseClient) AclCatWithCategory(ctx context.Context, category string) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclCat, []string{category})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Generates a random password of 256 bits, using the cryptographically secure random generator of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The password, as a hex encoded string of 64 characters.
+//
+// [valkey.io]: https://valkey.io/commands/acl-genpass/
+func (client *baseClient) AclGenPass(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclGenPass, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Generates a random password of the given number of bits, using the cryptographically secure random generator of the
+// server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	bits - The number of bits of the password, rounded up to the next multiple of 4. Must be between 1 and 4096.
+//
+// Return value:
+//
+//	The password, as a hex encoded string of one character per 4 bits.
+//
+// [valkey.io]: https://valkey.io/commands/acl-genpass/
+func (client *baseClient) AclGenPassWithBits(ctx context.Context, bits int64) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclGenPass, []string{utils.IntToString(bits)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Saves the users to the ACL file configured on the server.
+//
+// Note:
+//
+//	When in cluster mode, this command will be routed to all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success. An error is returned when the server isn't configured with an ACL file.
+//
+// [valkey.io]: https://valkey.io/commands/acl-save/
+func (client *baseClient) AclSave(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclSave, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Estimates the memory used by a key and its value, including the overhead of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to estimate the memory usage of.
+//
+// Return value:
+//
+//	The memory usage of the key in bytes, or a nil [Result] when the key doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/memory-usage/
+func (client *baseClient) MemoryUsage(ctx context.Context, key string) (Result[int64], error) {
+	result, err := client.executeCommand(ctx, C.MemoryUsage, []string{key})
+	if err != nil {
+		return CreateNilInt64Result(), err
+	}
+	return handleIntOrNilResponse(result)
+}
+
+// Estimates the memory used by a key and its value, including the overhead of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to estimate the memory usage of.
+//	opts - The number of nested values sampled for collections. See [options.MemoryUsageOptions].
+//
+// Return value:
+//
+//	The memory usage of the key in bytes, or a nil [Result] when the key doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/memory-usage/
+func (client *baseClient) MemoryUsageWithOptions(
+	ctx context.Context,
+	key string,
+	opts options.MemoryUsageOptions,
+) (Result[int64], error) {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return CreateNilInt64Result(), err
+	}
+	result, err := client.executeCommand(ctx, C.MemoryUsage, append([]string{key}, args...))
+	if err != nil {
+		return CreateNilInt64Result(), err
+	}
+	return handleIntOrNilResponse(result)
+}
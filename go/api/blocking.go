@@ -0,0 +1,131 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+// unblockTimeout bounds the time spent unblocking the server side of a cancelled blocking command.
+const unblockTimeout = 5 * time.Second
+
+// blockingCommandKey returns the first key of a command which blocks the connection on the server side, and reports
+// whether the command is such a command. The core extends the request timeout of these commands to cover their block time.
+func blockingCommandKey(requestType protobuf.RequestType, args []string) (string, bool) {
+	switch requestType {
+	case protobuf.RequestType_BLPop, protobuf.RequestType_BRPop, protobuf.RequestType_BLMove,
+		protobuf.RequestType_BRPopLPush, protobuf.RequestType_BZPopMin, protobuf.RequestType_BZPopMax:
+		// The keys come first, followed by the timeout.
+		if len(args) > 1 {
+			return args[0], true
+		}
+	case protobuf.RequestType_BLMPop, protobuf.RequestType_BZMPop:
+		// The timeout and the number of keys come first.
+		if len(args) > 2 {
+			return args[2], true
+		}
+	case protobuf.RequestType_XRead, protobuf.RequestType_XReadGroup:
+		first := 0
+		if requestType == protobuf.RequestType_XReadGroup {
+			// Skip "GROUP", the group and the consumer, which could be named "BLOCK".
+			first = 3
+		}
+		block := false
+		for i := first; i < len(args); i++ {
+			switch args[i] {
+			case options.BlockKeyword:
+				block = true
+			case options.StreamsKeyword:
+				if block && i+1 < len(args) {
+					return args[i+1], true
+				}
+				return "", false
+			}
+		}
+	}
+	return "", false
+}
+
+// sendBlockingCommand sends a command which blocks the connection on the server side. When ctx is done before the
+// command completes, the server side is unblocked with CLIENT UNBLOCK, sent on a separate connection since the connection
+// of the command is still blocked.
+func (client *baseClient) sendBlockingCommand(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+	key string,
+) (*C.struct_CommandResponse, error) {
+	if ctx.Done() == nil {
+		// ctx is never done, so the command never needs to be unblocked.
+		return client.sendCommandToCore(ctx, requestType, args, route)
+	}
+	// Both the connection ID and CLIENT UNBLOCK are routed to the primary serving the key, like the command itself. The
+	// client ID is fetched on every such blocking command, since it changes whenever the connection is re-established.
+	unblockRoute := route
+	if client.clusterSlots != nil && unblockRoute == nil {
+		unblockRoute = config.NewSlotKeyRoute(config.SlotTypePrimary, key)
+	}
+	connectionID, err := client.connectionID(ctx, unblockRoute)
+	if err != nil {
+		return nil, err
+	}
+	response, err := client.sendCommandToCore(ctx, requestType, args, route)
+	if err != nil && (ctx.Err() != nil || deadlineExceeded(ctx)) {
+		go client.unblock(connectionID, unblockRoute)
+	}
+	return response, err
+}
+
+// connectionID returns the ID that the server assigned to the connection of the client, as returned by CLIENT ID.
+func (client *baseClient) connectionID(ctx context.Context, route config.Route) (int64, error) {
+	response, err := client.sendCommandToCore(ctx, C.ClientId, nil, route)
+	if err != nil {
+		return 0, err
+	}
+	return handleIntResponse(response)
+}
+
+// unblock unblocks the connection with the given ID, by sending CLIENT UNBLOCK on a separate connection.
+func (client *baseClient) unblock(connectionID int64, route config.Route) {
+	unblockClient, err := client.getUnblockClient()
+	if err != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), unblockTimeout)
+	defer cancel()
+	args := []string{strconv.FormatInt(connectionID, 10)}
+	if response, err := unblockClient.sendCommandToCore(ctx, C.ClientUnblock, args, route); err == nil {
+		C.free_command_response(response)
+	}
+}
+
+// getUnblockClient returns the client used to send CLIENT UNBLOCK, which is connected on first use.
+func (client *baseClient) getUnblockClient() (*baseClient, error) {
+	client.unblockMu.Lock()
+	defer client.unblockMu.Unlock()
+	select {
+	case <-client.closed:
+		return nil, &errors.ClosingError{Msg: "The client is closed."}
+	default:
+	}
+	if client.unblockClient != nil {
+		return client.unblockClient, nil
+	}
+	unblockClient, err := createClient(client.connectionConfig)
+	if err != nil {
+		return nil, err
+	}
+	client.unblockClient = unblockClient
+	return unblockClient, nil
+}
@@ -0,0 +1,33 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+func TestBlockingCommandKey(t *testing.T) {
+	tests := []struct {
+		requestType protobuf.RequestType
+		args        []string
+		key         string
+		blocking    bool
+	}{
+		{protobuf.RequestType_BLPop, []string{"a", "b", "1"}, "a", true},
+		{protobuf.RequestType_BLMove, []string{"a", "b", "LEFT", "RIGHT", "1"}, "a", true},
+		{protobuf.RequestType_BZMPop, []string{"1", "2", "a", "b", "MIN"}, "a", true},
+		{protobuf.RequestType_XRead, []string{"BLOCK", "100", "STREAMS", "a", "0"}, "a", true},
+		{protobuf.RequestType_XRead, []string{"COUNT", "1", "STREAMS", "a", "0"}, "", false},
+		{protobuf.RequestType_XReadGroup, []string{"GROUP", "g", "c", "BLOCK", "0", "STREAMS", "a", ">"}, "a", true},
+		{protobuf.RequestType_XReadGroup, []string{"GROUP", "BLOCK", "c", "STREAMS", "a", ">"}, "", false},
+		{protobuf.RequestType_Get, []string{"a"}, "", false},
+	}
+	for _, test := range tests {
+		key, blocking := blockingCommandKey(test.requestType, test.args)
+		assert.Equal(t, test.blocking, blocking, test.requestType.String())
+		assert.Equal(t, test.key, key, test.requestType.String())
+	}
+}
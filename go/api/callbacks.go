@@ -8,6 +8,7 @@ import "C"
 import (
 	"log"
 	"sync"
+	"sync/atomic"
 	"unsafe"
 
 	"github.com/valkey-io/valkey-glide/go/api/errors"
@@ -40,19 +41,175 @@ func getClientByPtr(ptrValue uintptr) *baseClient {
 	return clientRegistry[ptrValue]
 }
 
+// pendingRequest receives the result of a request sent to the core, from the callbacks. The core identifies the request by
+// its ID, which the callbacks look up in pendingRequests, so that the request doesn't need to be pinned.
+type pendingRequest struct {
+	// client is the client the request was sent with, whose requests are failed when it's closed.
+	client *baseClient
+	// id is the ID passed to the core with the request, once registered in pendingRequests.
+	id uintptr
+	// core is the core client the request was sent with, whose requests are failed when it's retired after a failover.
+	core unsafe.Pointer
+	// shared is set for the requests shared by several commands, such as a fire-and-forget batch, which stay registered
+	// until unregistered by their owner instead of being removed by the first callback.
+	shared bool
+	// result receives the result of a request whose sender waits for it. The channel is buffered, so that the callbacks
+	// don't block.
+	result chan payload
+	// complete receives the result instead of result, for requests sent without waiting, such as with [AsyncClient].
+	complete func(payload)
+}
+
+// requestPool recycles the pending requests of the commands whose sender waits for the result, along with their channel.
+var requestPool = sync.Pool{
+	New: func() any { return &pendingRequest{result: make(chan payload, 1)} },
+}
+
+// newPendingRequest returns a pending request of client, whose sender waits for the result. The request is returned to
+// the pool with free once its result was received.
+func newPendingRequest(client *baseClient) *pendingRequest {
+	request := requestPool.Get().(*pendingRequest)
+	request.client = client
+	return request
+}
+
+// free returns the request to the pool. The request must have been removed from pendingRequests, and its result received.
+func (request *pendingRequest) free() {
+	request.client = nil
+	request.id = 0
+	request.core = nil
+	requestPool.Put(request)
+}
+
+// deliver hands the result of the request to its sender.
+func (request *pendingRequest) deliver(result payload) {
+	if request.complete != nil {
+		request.complete(result)
+		return
+	}
+	request.result <- result
+}
+
+// pendingRequests holds the requests sent to the core and not completed yet, by ID.
+var pendingRequests requestRegistry
+
+// requestRegistryShards is the number of shards of the requestRegistry, spreading the contention of concurrent requests.
+const requestRegistryShards = 64
+
+// requestRegistry maps the IDs passed to the core with the requests to the pending requests. A request is removed from the
+// registry by the first of its callback and the closing of its client, which is the only one to deliver a result to the
+// request.
+type requestRegistry struct {
+	nextId atomic.Uint64
+	shards [requestRegistryShards]requestRegistryShard
+}
+
+type requestRegistryShard struct {
+	mu       sync.Mutex
+	requests map[uintptr]*pendingRequest
+}
+
+func (registry *requestRegistry) shard(id uintptr) *requestRegistryShard {
+	return &registry.shards[id%requestRegistryShards]
+}
+
+// register assigns a new ID to request and adds it to the registry.
+func (registry *requestRegistry) register(request *pendingRequest) {
+	// IDs start at 1, so that 0 is never the ID of a registered request.
+	request.id = uintptr(registry.nextId.Add(1))
+	shard := registry.shard(request.id)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	if shard.requests == nil {
+		shard.requests = make(map[uintptr]*pendingRequest)
+	}
+	shard.requests[request.id] = request
+}
+
+// claim returns the request with the ID, removing it from the registry unless it's shared. Returns nil when there is no
+// such request, such as when the request was already failed because its client was closed.
+func (registry *requestRegistry) claim(id uintptr) *pendingRequest {
+	shard := registry.shard(id)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	request, ok := shard.requests[id]
+	if ok && !request.shared {
+		delete(shard.requests, id)
+	}
+	return request
+}
+
+// unregister removes the request with the ID from the registry.
+func (registry *requestRegistry) unregister(id uintptr) {
+	shard := registry.shard(id)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	delete(shard.requests, id)
+}
+
+// contains reports whether the request with the ID is in the registry.
+func (registry *requestRegistry) contains(id uintptr) bool {
+	shard := registry.shard(id)
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	_, ok := shard.requests[id]
+	return ok
+}
+
+// claimClient removes the requests of client from the registry, except the shared ones, and returns them.
+func (registry *requestRegistry) claimClient(client *baseClient) []*pendingRequest {
+	var requests []*pendingRequest
+	for i := range registry.shards {
+		shard := &registry.shards[i]
+		shard.mu.Lock()
+		for id, request := range shard.requests {
+			if request.client == client && !request.shared {
+				delete(shard.requests, id)
+				requests = append(requests, request)
+			}
+		}
+		shard.mu.Unlock()
+	}
+	return requests
+}
+
+// claimCore removes the requests sent with the core client from the registry, except the shared ones, and returns them.
+func (registry *requestRegistry) claimCore(core unsafe.Pointer) []*pendingRequest {
+	var requests []*pendingRequest
+	for i := range registry.shards {
+		shard := &registry.shards[i]
+		shard.mu.Lock()
+		for id, request := range shard.requests {
+			if request.core == core && !request.shared {
+				delete(shard.requests, id)
+				requests = append(requests, request)
+			}
+		}
+		shard.mu.Unlock()
+	}
+	return requests
+}
+
 //export successCallback
-func successCallback(channelPtr unsafe.Pointer, cResponse *C.struct_CommandResponse) {
-	response := cResponse
-	resultChannel := *(*chan payload)(getPinnedPtr(channelPtr))
-	resultChannel <- payload{value: response, error: nil}
+func successCallback(id C.uintptr_t, cResponse *C.struct_CommandResponse) {
+	request := pendingRequests.claim(uintptr(id))
+	if request == nil {
+		// The request was already failed when its client was closed.
+		C.free_command_response(cResponse)
+		return
+	}
+	request.deliver(payload{value: cResponse, error: nil})
 }
 
 //export failureCallback
-func failureCallback(channelPtr unsafe.Pointer, cErrorMessage *C.char, cErrorType C.RequestErrorType) {
+func failureCallback(id C.uintptr_t, cErrorMessage *C.char, cErrorType C.RequestErrorType) {
 	defer C.free_error_message(cErrorMessage)
+	request := pendingRequests.claim(uintptr(id))
+	if request == nil {
+		return
+	}
 	msg := C.GoString(cErrorMessage)
-	resultChannel := *(*chan payload)(getPinnedPtr(channelPtr))
-	resultChannel <- payload{value: nil, error: errors.GoError(uint32(cErrorType), msg)}
+	request.deliver(payload{value: nil, error: errors.GoError(uint32(cErrorType), msg)})
 }
 
 //
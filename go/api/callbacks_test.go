@@ -0,0 +1,90 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+)
+
+func TestRequestRegistry_Claim(t *testing.T) {
+	var registry requestRegistry
+	first, second := &pendingRequest{}, &pendingRequest{}
+	registry.register(first)
+	registry.register(second)
+	assert.NotZero(t, first.id)
+	assert.NotEqual(t, first.id, second.id)
+
+	// A request is claimed once, by the first of its callback and the closing of its client.
+	assert.Same(t, first, registry.claim(first.id))
+	assert.Nil(t, registry.claim(first.id))
+	assert.False(t, registry.contains(first.id))
+	assert.True(t, registry.contains(second.id))
+}
+
+func TestRequestRegistry_Shared(t *testing.T) {
+	var registry requestRegistry
+	client := &baseClient{}
+	shared := &pendingRequest{client: client, shared: true}
+	registry.register(shared)
+
+	assert.Same(t, shared, registry.claim(shared.id))
+	assert.Same(t, shared, registry.claim(shared.id))
+	assert.Empty(t, registry.claimClient(client))
+
+	registry.unregister(shared.id)
+	assert.Nil(t, registry.claim(shared.id))
+}
+
+func TestRequestRegistry_ClaimClient(t *testing.T) {
+	var registry requestRegistry
+	client, other := &baseClient{}, &baseClient{}
+	requests := []*pendingRequest{{client: client}, {client: client}, {client: other}}
+	for _, request := range requests {
+		registry.register(request)
+	}
+
+	assert.ElementsMatch(t, requests[:2], registry.claimClient(client))
+	assert.Empty(t, registry.claimClient(client))
+	assert.Same(t, requests[2], registry.claim(requests[2].id))
+}
+
+func TestPendingRequest_Pool(t *testing.T) {
+	client := &baseClient{}
+	request := newPendingRequest(client)
+	assert.Same(t, client, request.client)
+	request.deliver(payload{})
+	<-request.result
+	request.free()
+	assert.Nil(t, request.client)
+	assert.Zero(t, request.id)
+}
+
+func TestCommandBuffers(t *testing.T) {
+	buffers := &commandBuffers{}
+	argsPtr, lengthsPtr := buffers.setArgs(nil)
+	assert.Nil(t, argsPtr)
+	assert.Nil(t, lengthsPtr)
+
+	buffers.setArgs([]string{"key", "", "value"})
+	assert.Len(t, buffers.args, 3)
+	assert.Zero(t, buffers.args[1])
+	assert.EqualValues(t, []uint64{3, 0, 5}, []uint64{uint64(buffers.lengths[0]), uint64(buffers.lengths[1]),
+		uint64(buffers.lengths[2])})
+
+	// The buffers are reused by the next command.
+	buffers.setArgs([]string{"key"})
+	assert.Len(t, buffers.args, 1)
+	assert.GreaterOrEqual(t, cap(buffers.args), 3)
+
+	routePtr, routeLength, err := buffers.setRoute(nil)
+	assert.NoError(t, err)
+	assert.Nil(t, routePtr)
+	assert.Zero(t, routeLength)
+	_, routeLength, err = buffers.setRoute(config.AllPrimaries)
+	assert.NoError(t, err)
+	assert.EqualValues(t, len(buffers.route), routeLength)
+	assert.NotZero(t, routeLength)
+}
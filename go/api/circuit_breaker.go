@@ -0,0 +1,484 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	goErrors "errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+// CircuitState is the state of the circuit breaker of a node.
+type CircuitState int
+
+const (
+	// CircuitClosed - Commands are sent to the node normally.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen - Commands for the node fail fast with a [errors.CircuitOpenError].
+	CircuitOpen
+	// CircuitHalfOpen - A limited number of probe commands are sent to the node to check whether it recovered.
+	CircuitHalfOpen
+)
+
+func (state CircuitState) String() string {
+	switch state {
+	case CircuitClosed:
+		return "Closed"
+	case CircuitOpen:
+		return "Open"
+	case CircuitHalfOpen:
+		return "HalfOpen"
+	default:
+		return "CircuitState(" + strconv.Itoa(int(state)) + ")"
+	}
+}
+
+const (
+	defaultCircuitFailureThreshold = 5
+	defaultCircuitOpenDuration     = 5 * time.Second
+	defaultCircuitHalfOpenProbes   = 1
+)
+
+// CircuitBreakerConfig configures the per-node circuit breaker of a [GlideClusterClient].
+//
+// The client keeps one circuit per primary node. A circuit opens after FailureThreshold consecutive failures of the
+// commands sent to the node, where a failure is a timeout or a connection error, or a response slower than the latency
+// threshold. While the circuit is open, commands for the node fail immediately with a [errors.CircuitOpenError]. Once the
+// open duration elapses, the circuit becomes half-open and lets probe commands through: the circuit closes when all
+// probes succeed and opens again when a probe fails.
+//
+// Commands are attributed to a node by their key or route, using the slot map of the cluster. Commands without a key, such
+// as PING, commands routed to multiple nodes, custom commands without a route and blocking commands, such as BLPOP, are not
+// affected by the circuit breaker. Commands reading from replicas are attributed to the primary of their slot.
+type CircuitBreakerConfig struct {
+	failureThreshold int
+	latencyThreshold time.Duration
+	openDuration     time.Duration
+	halfOpenProbes   int
+	onStateChange    func(node string, from CircuitState, to CircuitState)
+}
+
+// NewCircuitBreakerConfig returns a [CircuitBreakerConfig] with default settings: the circuit opens after 5 consecutive
+// failures, stays open for 5 seconds and then sends a single probe. For further configuration, use the
+// [CircuitBreakerConfig] With* methods.
+func NewCircuitBreakerConfig() *CircuitBreakerConfig {
+	return &CircuitBreakerConfig{
+		failureThreshold: defaultCircuitFailureThreshold,
+		openDuration:     defaultCircuitOpenDuration,
+		halfOpenProbes:   defaultCircuitHalfOpenProbes,
+	}
+}
+
+// WithFailureThreshold sets the number of consecutive failures that opens the circuit of a node.
+func (config *CircuitBreakerConfig) WithFailureThreshold(failureThreshold int) *CircuitBreakerConfig {
+	config.failureThreshold = failureThreshold
+	return config
+}
+
+// WithLatencyThreshold sets the duration above which a successful command is counted as a failure. If not set, only errors
+// are counted as failures.
+func (config *CircuitBreakerConfig) WithLatencyThreshold(latencyThreshold time.Duration) *CircuitBreakerConfig {
+	config.latencyThreshold = latencyThreshold
+	return config
+}
+
+// WithOpenDuration sets how long a circuit stays open before it becomes half-open.
+func (config *CircuitBreakerConfig) WithOpenDuration(openDuration time.Duration) *CircuitBreakerConfig {
+	config.openDuration = openDuration
+	return config
+}
+
+// WithHalfOpenProbes sets the number of probe commands sent to a node while its circuit is half-open. The circuit closes
+// once that many probes succeed.
+func (config *CircuitBreakerConfig) WithHalfOpenProbes(halfOpenProbes int) *CircuitBreakerConfig {
+	config.halfOpenProbes = halfOpenProbes
+	return config
+}
+
+// WithStateChangeCallback sets a function called whenever the circuit of a node changes state. The callback is called
+// synchronously from the goroutine executing the command and must not block.
+func (config *CircuitBreakerConfig) WithStateChangeCallback(
+	callback func(node string, from CircuitState, to CircuitState),
+) *CircuitBreakerConfig {
+	config.onStateChange = callback
+	return config
+}
+
+func (config *CircuitBreakerConfig) validate() error {
+	if config.failureThreshold < 1 {
+		return &errors.ConfigurationError{Msg: fmt.Sprintf("invalid circuit breaker failure threshold %d", config.failureThreshold)}
+	}
+	if config.halfOpenProbes < 1 {
+		return &errors.ConfigurationError{Msg: fmt.Sprintf("invalid circuit breaker half-open probes %d", config.halfOpenProbes)}
+	}
+	if config.openDuration <= 0 || config.latencyThreshold < 0 {
+		return &errors.ConfigurationError{Msg: "circuit breaker durations must be positive"}
+	}
+	return nil
+}
+
+// NodeCircuitStats holds the circuit breaker statistics of a node, as returned by
+// [GlideClusterClient.CircuitBreakerStats].
+type NodeCircuitStats struct {
+	// State is the current state of the circuit.
+	State CircuitState
+	// ConsecutiveFailures is the number of failures since the last success.
+	ConsecutiveFailures int
+	// Rejected is the number of commands that failed fast because the circuit was open.
+	Rejected int64
+}
+
+type nodeCircuit struct {
+	state          CircuitState
+	failures       int
+	openedAt       time.Time
+	probesInFlight int
+	probeSuccesses int
+	rejected       int64
+}
+
+type stateChange struct {
+	node     string
+	from, to CircuitState
+}
+
+type circuitBreaker struct {
+	config CircuitBreakerConfig
+	now    func() time.Time
+	slots  *clusterSlots
+
+	mu    sync.Mutex
+	nodes map[string]*nodeCircuit
+}
+
+func newCircuitBreaker(config CircuitBreakerConfig, slots *clusterSlots) *circuitBreaker {
+	return &circuitBreaker{config: config, now: time.Now, slots: slots, nodes: make(map[string]*nodeCircuit)}
+}
+
+func (breaker *circuitBreaker) slotOwner(slot int) string {
+	if shard := breaker.slots.shard(slot); shard != nil {
+		return shard.primary
+	}
+	return ""
+}
+
+// nodeFor returns the address of the node serving the command, or an empty string for commands which aren't attributed to
+// a single node. Blocking commands aren't attributed to their node either, since they wait on the server for as long as
+// they were asked to, and may complete without a reply.
+func (breaker *circuitBreaker) nodeFor(requestType protobuf.RequestType, args []string, route config.Route) string {
+	if _, ok := blockingCommandKey(requestType, args); ok {
+		return ""
+	}
+	switch route := route.(type) {
+	case nil:
+		if _, ok := firstKeyRequestTypes[requestType]; ok && len(args) > 0 {
+			return breaker.slotOwner(keyHashSlot(args[0]))
+		}
+	case *config.ByAddressRoute:
+		return net.JoinHostPort(route.Host, strconv.Itoa(int(route.Port)))
+	case *config.SlotKeyRoute:
+		return breaker.slotOwner(keyHashSlot(route.SlotKey))
+	case *config.SlotIdRoute:
+		return breaker.slotOwner(int(route.SlotID))
+	}
+	return ""
+}
+
+// acquire checks whether a command may be sent to node. On success, the returned function must be called with the latency
+// and the error of the command.
+func (breaker *circuitBreaker) acquire(node string) (func(time.Duration, error), error) {
+	breaker.mu.Lock()
+	circuit, ok := breaker.nodes[node]
+	if !ok {
+		circuit = &nodeCircuit{}
+		breaker.nodes[node] = circuit
+	}
+	var changes []stateChange
+	if circuit.state == CircuitOpen && breaker.now().Sub(circuit.openedAt) >= breaker.config.openDuration {
+		changes = append(changes, breaker.transition(node, circuit, CircuitHalfOpen))
+	}
+	probe := false
+	switch circuit.state {
+	case CircuitOpen:
+		circuit.rejected++
+		breaker.mu.Unlock()
+		breaker.notify(changes)
+		return nil, &errors.CircuitOpenError{Node: node}
+	case CircuitHalfOpen:
+		if circuit.probesInFlight+circuit.probeSuccesses >= breaker.config.halfOpenProbes {
+			circuit.rejected++
+			breaker.mu.Unlock()
+			breaker.notify(changes)
+			return nil, &errors.CircuitOpenError{Node: node}
+		}
+		circuit.probesInFlight++
+		probe = true
+	}
+	breaker.mu.Unlock()
+	breaker.notify(changes)
+
+	return func(latency time.Duration, err error) {
+		breaker.record(node, probe, latency, err)
+	}, nil
+}
+
+func (breaker *circuitBreaker) record(node string, probe bool, latency time.Duration, err error) {
+	failure := isNodeFailure(err) ||
+		(err == nil && breaker.config.latencyThreshold > 0 && latency > breaker.config.latencyThreshold)
+
+	// A command cancelled by the caller tells nothing about the node, while an error reported by the server shows that the
+	// node is responsive.
+	healthy := !failure && !goErrors.Is(err, context.Canceled) && !goErrors.Is(err, context.DeadlineExceeded)
+
+	breaker.mu.Lock()
+	circuit := breaker.nodes[node]
+	var changes []stateChange
+	if failure {
+		circuit.failures++
+	} else if healthy {
+		circuit.failures = 0
+	}
+	switch {
+	case probe && circuit.state == CircuitHalfOpen:
+		circuit.probesInFlight--
+		if failure {
+			changes = append(changes, breaker.transition(node, circuit, CircuitOpen))
+		} else if healthy {
+			circuit.probeSuccesses++
+			if circuit.probeSuccesses >= breaker.config.halfOpenProbes {
+				changes = append(changes, breaker.transition(node, circuit, CircuitClosed))
+			}
+		}
+	case circuit.state == CircuitClosed && circuit.failures >= breaker.config.failureThreshold:
+		changes = append(changes, breaker.transition(node, circuit, CircuitOpen))
+	}
+	breaker.mu.Unlock()
+	breaker.notify(changes)
+}
+
+// transition moves circuit to the given state. It must be called with breaker.mu held.
+func (breaker *circuitBreaker) transition(node string, circuit *nodeCircuit, to CircuitState) stateChange {
+	change := stateChange{node: node, from: circuit.state, to: to}
+	circuit.state = to
+	circuit.probesInFlight = 0
+	circuit.probeSuccesses = 0
+	switch to {
+	case CircuitOpen:
+		circuit.openedAt = breaker.now()
+		// The node may have failed over, so the slot map is refreshed.
+		breaker.slots.requestRefresh()
+	case CircuitClosed:
+		circuit.failures = 0
+	}
+	return change
+}
+
+func (breaker *circuitBreaker) notify(changes []stateChange) {
+	if breaker.config.onStateChange == nil {
+		return
+	}
+	for _, change := range changes {
+		breaker.config.onStateChange(change.node, change.from, change.to)
+	}
+}
+
+func (breaker *circuitBreaker) stats() map[string]NodeCircuitStats {
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	stats := make(map[string]NodeCircuitStats, len(breaker.nodes))
+	for node, circuit := range breaker.nodes {
+		state := circuit.state
+		if state == CircuitOpen && breaker.now().Sub(circuit.openedAt) >= breaker.config.openDuration {
+			state = CircuitHalfOpen
+		}
+		stats[node] = NodeCircuitStats{State: state, ConsecutiveFailures: circuit.failures, Rejected: circuit.rejected}
+	}
+	return stats
+}
+
+// isNodeFailure reports whether err indicates that the node is unhealthy, as opposed to an error reported by a healthy
+// node or a cancellation by the caller. A command whose context expired is reported as a [errors.TimeoutError] wrapping
+// context.DeadlineExceeded, which reflects the deadline chosen by the caller rather than the health of the node.
+func isNodeFailure(err error) bool {
+	if err == nil || goErrors.Is(err, context.Canceled) || goErrors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var timeoutErr *errors.TimeoutError
+	var connectionErr *errors.ConnectionError
+	var disconnectErr *errors.DisconnectError
+	return goErrors.As(err, &timeoutErr) || goErrors.As(err, &connectionErr) || goErrors.As(err, &disconnectErr)
+}
+
+// firstKeyRequestTypes holds the commands routed by the slot of their first argument.
+var firstKeyRequestTypes = map[protobuf.RequestType]struct{}{
+	protobuf.RequestType_Append:                    {},
+	protobuf.RequestType_BLMove:                    {},
+	protobuf.RequestType_BLPop:                     {},
+	protobuf.RequestType_BRPop:                     {},
+	protobuf.RequestType_BRPopLPush:                {},
+	protobuf.RequestType_BZPopMax:                  {},
+	protobuf.RequestType_BZPopMin:                  {},
+	protobuf.RequestType_BitCount:                  {},
+	protobuf.RequestType_BitField:                  {},
+	protobuf.RequestType_BitFieldReadOnly:          {},
+	protobuf.RequestType_BitPos:                    {},
+	protobuf.RequestType_Copy:                      {},
+	protobuf.RequestType_Decr:                      {},
+	protobuf.RequestType_DecrBy:                    {},
+	protobuf.RequestType_Dump:                      {},
+	protobuf.RequestType_Expire:                    {},
+	protobuf.RequestType_ExpireAt:                  {},
+	protobuf.RequestType_ExpireTime:                {},
+	protobuf.RequestType_GeoAdd:                    {},
+	protobuf.RequestType_GeoDist:                   {},
+	protobuf.RequestType_GeoHash:                   {},
+	protobuf.RequestType_GeoPos:                    {},
+	protobuf.RequestType_GeoRadius:                 {},
+	protobuf.RequestType_GeoRadiusByMember:         {},
+	protobuf.RequestType_GeoRadiusByMemberReadOnly: {},
+	protobuf.RequestType_GeoRadiusReadOnly:         {},
+	protobuf.RequestType_GeoSearch:                 {},
+	protobuf.RequestType_GeoSearchStore:            {},
+	protobuf.RequestType_Get:                       {},
+	protobuf.RequestType_GetBit:                    {},
+	protobuf.RequestType_GetDel:                    {},
+	protobuf.RequestType_GetEx:                     {},
+	protobuf.RequestType_GetRange:                  {},
+	protobuf.RequestType_GetSet:                    {},
+	protobuf.RequestType_HDel:                      {},
+	protobuf.RequestType_HExists:                   {},
+	protobuf.RequestType_HGet:                      {},
+	protobuf.RequestType_HGetAll:                   {},
+	protobuf.RequestType_HIncrBy:                   {},
+	protobuf.RequestType_HIncrByFloat:              {},
+	protobuf.RequestType_HKeys:                     {},
+	protobuf.RequestType_HLen:                      {},
+	protobuf.RequestType_HMGet:                     {},
+	protobuf.RequestType_HMSet:                     {},
+	protobuf.RequestType_HRandField:                {},
+	protobuf.RequestType_HScan:                     {},
+	protobuf.RequestType_HSet:                      {},
+	protobuf.RequestType_HSetNX:                    {},
+	protobuf.RequestType_HStrlen:                   {},
+	protobuf.RequestType_HVals:                     {},
+	protobuf.RequestType_Incr:                      {},
+	protobuf.RequestType_IncrBy:                    {},
+	protobuf.RequestType_IncrByFloat:               {},
+	protobuf.RequestType_LCS:                       {},
+	protobuf.RequestType_LIndex:                    {},
+	protobuf.RequestType_LInsert:                   {},
+	protobuf.RequestType_LLen:                      {},
+	protobuf.RequestType_LMove:                     {},
+	protobuf.RequestType_LPop:                      {},
+	protobuf.RequestType_LPos:                      {},
+	protobuf.RequestType_LPush:                     {},
+	protobuf.RequestType_LPushX:                    {},
+	protobuf.RequestType_LRange:                    {},
+	protobuf.RequestType_LRem:                      {},
+	protobuf.RequestType_LSet:                      {},
+	protobuf.RequestType_LTrim:                     {},
+	protobuf.RequestType_MemoryUsage:               {},
+	protobuf.RequestType_Move:                      {},
+	protobuf.RequestType_ObjectEncoding:            {},
+	protobuf.RequestType_ObjectFreq:                {},
+	protobuf.RequestType_ObjectIdleTime:            {},
+	protobuf.RequestType_ObjectRefCount:            {},
+	protobuf.RequestType_PExpire:                   {},
+	protobuf.RequestType_PExpireAt:                 {},
+	protobuf.RequestType_PExpireTime:               {},
+	protobuf.RequestType_PSetEx:                    {},
+	protobuf.RequestType_PTTL:                      {},
+	protobuf.RequestType_Persist:                   {},
+	protobuf.RequestType_PfAdd:                     {},
+	protobuf.RequestType_PfCount:                   {},
+	protobuf.RequestType_PfMerge:                   {},
+	protobuf.RequestType_RPop:                      {},
+	protobuf.RequestType_RPopLPush:                 {},
+	protobuf.RequestType_RPush:                     {},
+	protobuf.RequestType_RPushX:                    {},
+	protobuf.RequestType_Rename:                    {},
+	protobuf.RequestType_RenameNX:                  {},
+	protobuf.RequestType_Restore:                   {},
+	protobuf.RequestType_SAdd:                      {},
+	protobuf.RequestType_SCard:                     {},
+	protobuf.RequestType_SDiff:                     {},
+	protobuf.RequestType_SDiffStore:                {},
+	protobuf.RequestType_SInter:                    {},
+	protobuf.RequestType_SInterStore:               {},
+	protobuf.RequestType_SIsMember:                 {},
+	protobuf.RequestType_SMIsMember:                {},
+	protobuf.RequestType_SMembers:                  {},
+	protobuf.RequestType_SMove:                     {},
+	protobuf.RequestType_SPop:                      {},
+	protobuf.RequestType_SPublish:                  {},
+	protobuf.RequestType_SRandMember:               {},
+	protobuf.RequestType_SRem:                      {},
+	protobuf.RequestType_SScan:                     {},
+	protobuf.RequestType_SUnion:                    {},
+	protobuf.RequestType_SUnionStore:               {},
+	protobuf.RequestType_Set:                       {},
+	protobuf.RequestType_SetBit:                    {},
+	protobuf.RequestType_SetEx:                     {},
+	protobuf.RequestType_SetNX:                     {},
+	protobuf.RequestType_SetRange:                  {},
+	protobuf.RequestType_Sort:                      {},
+	protobuf.RequestType_SortReadOnly:              {},
+	protobuf.RequestType_Strlen:                    {},
+	protobuf.RequestType_Substr:                    {},
+	protobuf.RequestType_TTL:                       {},
+	protobuf.RequestType_Type:                      {},
+	protobuf.RequestType_XAck:                      {},
+	protobuf.RequestType_XAdd:                      {},
+	protobuf.RequestType_XAutoClaim:                {},
+	protobuf.RequestType_XClaim:                    {},
+	protobuf.RequestType_XDel:                      {},
+	protobuf.RequestType_XGroupCreate:              {},
+	protobuf.RequestType_XGroupCreateConsumer:      {},
+	protobuf.RequestType_XGroupDelConsumer:         {},
+	protobuf.RequestType_XGroupDestroy:             {},
+	protobuf.RequestType_XGroupSetId:               {},
+	protobuf.RequestType_XInfoConsumers:            {},
+	protobuf.RequestType_XInfoGroups:               {},
+	protobuf.RequestType_XInfoStream:               {},
+	protobuf.RequestType_XLen:                      {},
+	protobuf.RequestType_XPending:                  {},
+	protobuf.RequestType_XRange:                    {},
+	protobuf.RequestType_XRevRange:                 {},
+	protobuf.RequestType_XSetId:                    {},
+	protobuf.RequestType_XTrim:                     {},
+	protobuf.RequestType_ZAdd:                      {},
+	protobuf.RequestType_ZCard:                     {},
+	protobuf.RequestType_ZCount:                    {},
+	protobuf.RequestType_ZDiffStore:                {},
+	protobuf.RequestType_ZIncrBy:                   {},
+	protobuf.RequestType_ZInterStore:               {},
+	protobuf.RequestType_ZLexCount:                 {},
+	protobuf.RequestType_ZMScore:                   {},
+	protobuf.RequestType_ZPopMax:                   {},
+	protobuf.RequestType_ZPopMin:                   {},
+	protobuf.RequestType_ZRandMember:               {},
+	protobuf.RequestType_ZRange:                    {},
+	protobuf.RequestType_ZRangeByLex:               {},
+	protobuf.RequestType_ZRangeByScore:             {},
+	protobuf.RequestType_ZRangeStore:               {},
+	protobuf.RequestType_ZRank:                     {},
+	protobuf.RequestType_ZRem:                      {},
+	protobuf.RequestType_ZRemRangeByLex:            {},
+	protobuf.RequestType_ZRemRangeByRank:           {},
+	protobuf.RequestType_ZRemRangeByScore:          {},
+	protobuf.RequestType_ZRevRange:                 {},
+	protobuf.RequestType_ZRevRangeByLex:            {},
+	protobuf.RequestType_ZRevRangeByScore:          {},
+	protobuf.RequestType_ZRevRank:                  {},
+	protobuf.RequestType_ZScan:                     {},
+	protobuf.RequestType_ZScore:                    {},
+	protobuf.RequestType_ZUnionStore:               {},
+}
@@ -0,0 +1,212 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+// fakeClock is a clock advanced by the tests. It is safe for concurrent use, since the slot map is refreshed in the
+// background.
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock { return &fakeClock{now: time.Now()} }
+
+func (clock *fakeClock) Now() time.Time {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	return clock.now
+}
+
+func (clock *fakeClock) advance(duration time.Duration) {
+	clock.mu.Lock()
+	defer clock.mu.Unlock()
+	clock.now = clock.now.Add(duration)
+}
+
+func newTestClusterSlots(clock *fakeClock) *clusterSlots {
+	slots := newClusterSlots(func(ctx context.Context) (*slotMap, error) {
+		slots := &slotMap{}
+		first := &shardNodes{primary: "10.0.0.1:6379", replicas: []string{"10.0.0.3:6379", "10.0.0.4:6379"}}
+		second := &shardNodes{primary: "10.0.0.2:6379"}
+		for slot := range slots {
+			if slot < slotCount/2 {
+				slots[slot] = first
+			} else {
+				slots[slot] = second
+			}
+		}
+		return slots, nil
+	})
+	slots.now = clock.Now
+	slots.refresh(context.Background())
+	return slots
+}
+
+func newTestCircuitBreaker(config *CircuitBreakerConfig, clock *fakeClock) *circuitBreaker {
+	breaker := newCircuitBreaker(*config, newTestClusterSlots(clock))
+	breaker.now = clock.Now
+	return breaker
+}
+
+func TestCircuitBreaker_NodeFor(t *testing.T) {
+	breaker := newTestCircuitBreaker(NewCircuitBreakerConfig(), newFakeClock())
+
+	// "foo" hashes to slot 12182 and "123456789" to slot 12739, while "user1000" hashes to slot 3443.
+	assert.Equal(t, "10.0.0.2:6379", breaker.nodeFor(protobuf.RequestType_Get, []string{"foo"}, nil))
+	assert.Equal(t, "10.0.0.1:6379", breaker.nodeFor(protobuf.RequestType_HSet, []string{"{user1000}.a", "f", "v"}, nil))
+	assert.Equal(t, "", breaker.nodeFor(protobuf.RequestType_Ping, nil, nil))
+	assert.Equal(t, "", breaker.nodeFor(protobuf.RequestType_MGet, []string{"foo", "bar"}, nil))
+	assert.Equal(t, "", breaker.nodeFor(protobuf.RequestType_CustomCommand, []string{"GET", "foo"}, nil))
+	assert.Equal(t, "", breaker.nodeFor(protobuf.RequestType_Get, []string{"foo"}, config.AllPrimaries))
+
+	assert.Equal(t, "10.0.0.1:6379",
+		breaker.nodeFor(protobuf.RequestType_CustomCommand, []string{"GET", "k"}, config.NewSlotIdRoute(config.SlotTypePrimary, 0)))
+	assert.Equal(t, "10.0.0.2:6379",
+		breaker.nodeFor(protobuf.RequestType_CustomCommand, nil, config.NewSlotKeyRoute(config.SlotTypePrimary, "foo")))
+	assert.Equal(t, "host:7000",
+		breaker.nodeFor(protobuf.RequestType_CustomCommand, nil, config.NewByAddressRoute("host", 7000)))
+}
+
+func TestCircuitBreaker_IgnoresBlockingCommands(t *testing.T) {
+	breaker := newTestCircuitBreaker(NewCircuitBreakerConfig(), newFakeClock())
+
+	// Blocking commands wait for as long as they were asked to, which isn't a sign of a slow node.
+	assert.Equal(t, "", breaker.nodeFor(protobuf.RequestType_BLPop, []string{"foo", "30"}, nil))
+	assert.Equal(t, "", breaker.nodeFor(protobuf.RequestType_BZPopMin, []string{"foo", "30"}, nil))
+	assert.Equal(t, "", breaker.nodeFor(protobuf.RequestType_BLMove, []string{"foo", "bar", "LEFT", "RIGHT", "30"}, nil))
+	route := config.NewSlotKeyRoute(config.SlotTypePrimary, "foo")
+	assert.Equal(t, "", breaker.nodeFor(protobuf.RequestType_BLPop, []string{"foo", "30"}, route))
+	// Their non-blocking counterparts are attributed to the node serving their key.
+	assert.Equal(t, "10.0.0.2:6379", breaker.nodeFor(protobuf.RequestType_LPop, []string{"foo"}, nil))
+}
+
+func TestCircuitBreaker_OpensAndRecovers(t *testing.T) {
+	clock := newFakeClock()
+	var changes []string
+	cfg := NewCircuitBreakerConfig().
+		WithFailureThreshold(2).
+		WithOpenDuration(time.Second).
+		WithHalfOpenProbes(2).
+		WithStateChangeCallback(func(node string, from CircuitState, to CircuitState) {
+			changes = append(changes, node+" "+from.String()+"->"+to.String())
+		})
+	breaker := newTestCircuitBreaker(cfg, clock)
+	node := "10.0.0.1:6379"
+	timeout := errors.NewTimeoutError("timed out")
+
+	for i := 0; i < 2; i++ {
+		done, err := breaker.acquire(node)
+		assert.NoError(t, err)
+		done(time.Millisecond, timeout)
+	}
+	_, err := breaker.acquire(node)
+	assert.Equal(t, &errors.CircuitOpenError{Node: node}, err)
+	assert.Equal(t, NodeCircuitStats{State: CircuitOpen, ConsecutiveFailures: 2, Rejected: 1}, breaker.stats()[node])
+
+	// Once the open duration elapses, only the configured number of probes is let through.
+	clock.advance(time.Second)
+	probe1, err := breaker.acquire(node)
+	assert.NoError(t, err)
+	probe2, err := breaker.acquire(node)
+	assert.NoError(t, err)
+	_, err = breaker.acquire(node)
+	assert.IsType(t, &errors.CircuitOpenError{}, err)
+
+	probe1(time.Millisecond, nil)
+	assert.Equal(t, CircuitHalfOpen, breaker.stats()[node].State)
+	probe2(time.Millisecond, nil)
+	assert.Equal(t, CircuitClosed, breaker.stats()[node].State)
+
+	assert.Equal(t, []string{
+		node + " Closed->Open",
+		node + " Open->HalfOpen",
+		node + " HalfOpen->Closed",
+	}, changes)
+}
+
+func TestCircuitBreaker_CallerDeadlineIsNotAFailure(t *testing.T) {
+	breaker := newTestCircuitBreaker(NewCircuitBreakerConfig().WithFailureThreshold(2), newFakeClock())
+	node := "10.0.0.1:6379"
+	deadline := errors.WrapTimeoutError("Request timed out: "+context.DeadlineExceeded.Error(), context.DeadlineExceeded)
+
+	for i := 0; i < 5; i++ {
+		done, err := breaker.acquire(node)
+		assert.NoError(t, err)
+		done(time.Millisecond, deadline)
+		done, err = breaker.acquire(node)
+		assert.NoError(t, err)
+		done(time.Millisecond, context.Canceled)
+	}
+	assert.Equal(t, NodeCircuitStats{State: CircuitClosed}, breaker.stats()[node])
+
+	// A timeout of the request itself still counts as a failure, without being reset by the caller deadlines.
+	done, _ := breaker.acquire(node)
+	done(time.Millisecond, errors.NewTimeoutError("timed out"))
+	done, _ = breaker.acquire(node)
+	done(time.Millisecond, deadline)
+	done, _ = breaker.acquire(node)
+	done(time.Millisecond, errors.NewTimeoutError("timed out"))
+	assert.Equal(t, CircuitOpen, breaker.stats()[node].State)
+}
+
+func TestCircuitBreaker_FailedProbeReopens(t *testing.T) {
+	clock := newFakeClock()
+	breaker := newTestCircuitBreaker(NewCircuitBreakerConfig().WithFailureThreshold(1), clock)
+	node := "10.0.0.2:6379"
+
+	done, _ := breaker.acquire(node)
+	done(time.Millisecond, errors.NewDisconnectError("disconnected"))
+	assert.Equal(t, CircuitOpen, breaker.stats()[node].State)
+
+	clock.advance(defaultCircuitOpenDuration)
+	probe, err := breaker.acquire(node)
+	assert.NoError(t, err)
+	probe(time.Millisecond, &errors.ConnectionError{Msg: "refused"})
+	assert.Equal(t, CircuitOpen, breaker.stats()[node].State)
+	_, err = breaker.acquire(node)
+	assert.IsType(t, &errors.CircuitOpenError{}, err)
+}
+
+func TestCircuitBreaker_CountsSlowResponsesOnly(t *testing.T) {
+	clock := newFakeClock()
+	cfg := NewCircuitBreakerConfig().WithFailureThreshold(2).WithLatencyThreshold(100 * time.Millisecond)
+	breaker := newTestCircuitBreaker(cfg, clock)
+	node := "10.0.0.1:6379"
+
+	record := func(latency time.Duration, err error) {
+		done, acquireErr := breaker.acquire(node)
+		assert.NoError(t, acquireErr)
+		done(latency, err)
+	}
+	record(time.Second, nil)
+	// Server errors and cancellations don't count as failures.
+	record(time.Millisecond, &errors.RequestError{Msg: "ERR syntax error"})
+	record(time.Millisecond, context.Canceled)
+	record(time.Second, nil)
+	assert.Equal(t, CircuitClosed, breaker.stats()[node].State)
+	record(time.Second, nil)
+	assert.Equal(t, CircuitOpen, breaker.stats()[node].State)
+}
+
+func TestCircuitBreakerConfig_Validate(t *testing.T) {
+	assert.NoError(t, NewCircuitBreakerConfig().validate())
+	assert.IsType(t, &errors.ConfigurationError{}, NewCircuitBreakerConfig().WithFailureThreshold(0).validate())
+	assert.IsType(t, &errors.ConfigurationError{}, NewCircuitBreakerConfig().WithHalfOpenProbes(0).validate())
+	assert.IsType(t, &errors.ConfigurationError{}, NewCircuitBreakerConfig().WithOpenDuration(0).validate())
+
+	clusterConfig := NewGlideClusterClientConfiguration().WithCircuitBreaker(NewCircuitBreakerConfig().WithFailureThreshold(0))
+	_, err := clusterConfig.toProtobuf()
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+}
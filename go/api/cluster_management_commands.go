@@ -0,0 +1,61 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+// Supports commands for the "Cluster Management" group for a cluster client. The commands describing the cluster run
+// on a random node, and the commands describing a node run on all nodes, unless they are given another route.
+//
+// See [valkey.io] for details.
+//
+// [valkey.io]: https://valkey.io/commands/#cluster
+type ClusterManagementCommands interface {
+	ClusterInfo(ctx context.Context) (ClusterValue[ClusterState], error)
+
+	ClusterInfoWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[ClusterState], error)
+
+	ClusterNodes(ctx context.Context) (ClusterValue[[]ClusterNode], error)
+
+	ClusterNodesWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[[]ClusterNode], error)
+
+	ClusterShards(ctx context.Context) (ClusterValue[[]ClusterShard], error)
+
+	ClusterShardsWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[[]ClusterShard], error)
+
+	ClusterSlots(ctx context.Context) (ClusterValue[[]ClusterSlotsEntry], error)
+
+	ClusterSlotsWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[[]ClusterSlotsEntry], error)
+
+	ClusterMyId(ctx context.Context) (ClusterValue[string], error)
+
+	ClusterMyIdWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[string], error)
+
+	ClusterMyShardId(ctx context.Context) (ClusterValue[string], error)
+
+	ClusterMyShardIdWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[string], error)
+
+	ClusterKeySlot(ctx context.Context, key string) (int64, error)
+
+	ClusterCountKeysInSlot(ctx context.Context, slot int64) (int64, error)
+
+	ClusterGetKeysInSlot(ctx context.Context, slot int64, count int64) ([]string, error)
+
+	ClusterLinks(ctx context.Context) (ClusterValue[[]ClusterLink], error)
+
+	ClusterLinksWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[[]ClusterLink], error)
+
+	ClusterSlotStats(ctx context.Context, opts options.SlotStatsOptions) (ClusterValue[[]SlotStats], error)
+
+	ClusterSlotStatsWithOptions(
+		ctx context.Context,
+		opts options.SlotStatsOptions,
+		routeOption options.RouteOption,
+	) (ClusterValue[[]SlotStats], error)
+
+	Topology(ctx context.Context) (ClusterTopology, error)
+}
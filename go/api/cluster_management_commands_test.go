@@ -0,0 +1,253 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+func TestConvertClusterState(t *testing.T) {
+	state, err := convertClusterState("cluster_state:ok\r\ncluster_slots_assigned:16384\r\ncluster_slots_ok:16384\r\n" +
+		"cluster_slots_pfail:0\r\ncluster_slots_fail:0\r\ncluster_known_nodes:6\r\ncluster_size:3\r\n" +
+		"cluster_current_epoch:6\r\ncluster_my_epoch:2\r\ncluster_stats_messages_sent:1483972\r\n")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", state.State)
+	assert.Equal(t, int64(16384), state.SlotsAssigned)
+	assert.Equal(t, int64(16384), state.SlotsOk)
+	assert.Equal(t, int64(6), state.KnownNodes)
+	assert.Equal(t, int64(3), state.Size)
+	assert.Equal(t, int64(6), state.CurrentEpoch)
+	assert.Equal(t, int64(2), state.MyEpoch)
+	assert.Equal(t, "1483972", state.Fields["cluster_stats_messages_sent"])
+
+	_, err = convertClusterState("cluster_state:ok\r\ncluster_size:three\r\n")
+	assert.Error(t, err)
+}
+
+func TestConvertClusterNodes(t *testing.T) {
+	nodes, err := convertClusterNodes(
+		"07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004,replica-host slave " +
+			"e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 0 1426238317239 4 connected\n" +
+			"e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected " +
+			"0-5460 5462 [5461->-292f8b365bb7edb5e285caf0b7e6ddc7265d2f4f]\n" +
+			"292f8b365bb7edb5e285caf0b7e6ddc7265d2f4f 127.0.0.1:30003@31003 master,fail - 1426238316232 " +
+			"1426238315228 3 disconnected\n",
+	)
+	require.NoError(t, err)
+	require.Len(t, nodes, 3)
+	assert.Equal(t, ClusterNode{
+		Id:           "07c37dfeb235213a872192d90877d0cd55635b91",
+		Address:      "127.0.0.1:30004",
+		BusPort:      31004,
+		Hostname:     "replica-host",
+		Flags:        []string{"slave"},
+		PrimaryId:    "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca",
+		PongReceived: 1426238317239,
+		ConfigEpoch:  4,
+		Connected:    true,
+		Slots:        []SlotRange{},
+	}, nodes[0])
+	assert.False(t, nodes[0].IsPrimary())
+
+	assert.True(t, nodes[1].IsPrimary())
+	assert.True(t, nodes[1].IsMyself())
+	assert.Empty(t, nodes[1].PrimaryId)
+	assert.Equal(t, []SlotRange{{Start: 0, End: 5460}, {Start: 5462, End: 5462}}, nodes[1].Slots)
+
+	assert.True(t, nodes[2].IsFailing())
+	assert.False(t, nodes[2].Connected)
+	assert.Equal(t, int64(1426238316232), nodes[2].PingSent)
+
+	_, err = convertClusterNodes("07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 slave\n")
+	assert.Error(t, err)
+}
+
+func TestEachNodeTargets(t *testing.T) {
+	nodes := []ClusterNode{
+		{Id: "a", Address: "10.0.0.1:6379", Flags: []string{"master"}, Slots: []SlotRange{{Start: 0, End: 16383}}},
+		{Id: "b", Address: "10.0.0.2:6379", Flags: []string{"slave"}, PrimaryId: "a"},
+		{Id: "c", Address: "10.0.0.3:6379", Flags: []string{"master"}},
+		{Id: "d", Address: "10.0.0.4:6379", Flags: []string{"slave"}, PrimaryId: "c"},
+		{Id: "e", Address: "10.0.0.5:6379", Flags: []string{"master", "fail"}},
+		{Id: "f", Address: ":0", Flags: []string{"master", "noaddr"}},
+	}
+	assert.Equal(t, nodes[:4], eachNodeTargets(nodes, false))
+	assert.Equal(t, []ClusterNode{nodes[0], nodes[2]}, eachNodeTargets(nodes, true))
+}
+
+func TestIsUnreachableNodeError(t *testing.T) {
+	assert.True(t, isUnreachableNodeError(errors.NewDisconnectError("Requested connection not found")))
+	assert.True(t, isUnreachableNodeError(&errors.ConnectionError{Msg: "closed"}))
+	assert.False(t, isUnreachableNodeError(errors.NewTimeoutError("timed out")))
+	assert.False(t, isUnreachableNodeError(nil))
+}
+
+func TestConvertClusterSlots(t *testing.T) {
+	entries, err := convertClusterSlots([]interface{}{
+		[]interface{}{
+			int64(0), int64(5460),
+			[]interface{}{"127.0.0.1", int64(30001), "09dbe9720cda62f7865eabc5fd8857c5d2678366", map[string]interface{}{}},
+			[]interface{}{
+				"127.0.0.1", int64(30004), "821d8ca00d7ccf931ed3ffc7e3db0599d2271abf",
+				map[string]interface{}{"hostname": "replica-host"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []ClusterSlotsEntry{{
+		Slots:   SlotRange{Start: 0, End: 5460},
+		Primary: ClusterSlotsNode{Endpoint: "127.0.0.1", Port: 30001, Id: "09dbe9720cda62f7865eabc5fd8857c5d2678366"},
+		Replicas: []ClusterSlotsNode{
+			{Endpoint: "127.0.0.1", Port: 30004, Id: "821d8ca00d7ccf931ed3ffc7e3db0599d2271abf", Hostname: "replica-host"},
+		},
+	}}, entries)
+
+	_, err = convertClusterSlots([]interface{}{[]interface{}{int64(0), int64(5460)}})
+	assert.Error(t, err)
+}
+
+func TestConvertClusterShards(t *testing.T) {
+	shards, err := convertClusterShards([]interface{}{
+		map[string]interface{}{
+			"slots": []interface{}{int64(0), int64(5460), int64(10923), int64(16383)},
+			"nodes": []interface{}{
+				map[string]interface{}{
+					"id":                 "e10b7051d6bf2d5febd39a2be297bbaea6084111",
+					"port":               int64(30001),
+					"ip":                 "127.0.0.1",
+					"endpoint":           "127.0.0.1",
+					"role":               "master",
+					"replication-offset": int64(72156),
+					"health":             "online",
+				},
+				map[string]interface{}{
+					"id":                 "1901f5962d865341e81c85f9f596b1e7160c35ce",
+					"tls-port":           int64(30006),
+					"ip":                 "127.0.0.1",
+					"endpoint":           "replica-host",
+					"hostname":           "replica-host",
+					"role":               "replica",
+					"replication-offset": int64(72100),
+					"health":             "loading",
+				},
+			},
+		},
+		map[string]interface{}{
+			"slots": []interface{}{int64(5461), int64(10922)},
+			"nodes": []interface{}{
+				map[string]interface{}{"id": "a", "port": int64(30002), "endpoint": "127.0.0.1", "role": "master", "health": "online"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, shards, 2)
+	assert.Equal(t, []SlotRange{{Start: 0, End: 5460}, {Start: 10923, End: 16383}}, shards[0].Slots)
+	primary, ok := shards[0].Primary()
+	assert.True(t, ok)
+	assert.Equal(t, "127.0.0.1:30001", primary.Address())
+	assert.Equal(t, int64(72156), primary.ReplicationOffset)
+	replicas := shards[0].Replicas()
+	require.Len(t, replicas, 1)
+	assert.Equal(t, "replica-host:30006", replicas[0].Address())
+	assert.Equal(t, "loading", replicas[0].Health)
+
+	topology := ClusterTopology{Shards: shards}
+	assert.True(t, topology.IsHealthy())
+	shard, ok := topology.ShardOfSlot(6000)
+	assert.True(t, ok)
+	assert.Equal(t, shards[1], shard)
+	assert.Len(t, topology.Primaries(), 2)
+	assert.Len(t, topology.Replicas(), 1)
+
+	topology = ClusterTopology{Shards: shards[:1]}
+	assert.False(t, topology.IsHealthy())
+	_, ok = topology.ShardOfSlot(6000)
+	assert.False(t, ok)
+
+	_, err = convertClusterShards([]interface{}{"shard"})
+	assert.Error(t, err)
+}
+
+func TestConvertClusterLinksAndSlotStats(t *testing.T) {
+	links, err := convertClusterLinks([]interface{}{
+		map[string]interface{}{
+			"direction":             "to",
+			"node":                  "8149d745fa551e40764fecaf7cab9dbdf6b659ae",
+			"create-time":           int64(1639442739375),
+			"events":                "rw",
+			"send-buffer-allocated": int64(4512),
+			"send-buffer-used":      int64(0),
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []ClusterLink{{
+		Direction:           "to",
+		Node:                "8149d745fa551e40764fecaf7cab9dbdf6b659ae",
+		CreateTime:          1639442739375,
+		Events:              "rw",
+		SendBufferAllocated: 4512,
+	}}, links)
+
+	stats, err := convertSlotStats([]interface{}{
+		[]interface{}{int64(100), map[string]interface{}{"key-count": int64(3), "cpu-usec": int64(12)}},
+		[]interface{}{int64(101), map[string]interface{}{"key-count": int64(0)}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []SlotStats{{Slot: 100, KeyCount: 3, CpuUsec: 12}, {Slot: 101}}, stats)
+
+	_, err = convertSlotStats([]interface{}{[]interface{}{int64(100)}})
+	assert.Error(t, err)
+}
+
+func TestSlotStatsOptions_ToArgs(t *testing.T) {
+	args, err := options.NewSlotStatsRangeOptions(0, 100).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SLOTSRANGE", "0", "100"}, args)
+
+	args, err = options.NewSlotStatsOrderByOptions(options.SlotStatsKeyCount).SetLimit(5).SetOrder(options.ASC).ToArgs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ORDERBY", "KEY-COUNT", "LIMIT", "5", "ASC"}, args)
+
+	_, err = (*options.SlotStatsOptions)(nil).ToArgs()
+	assert.Error(t, err)
+}
+
+func TestSlotRoute(t *testing.T) {
+	_, err := slotRoute(0)
+	assert.NoError(t, err)
+	_, err = slotRoute(16383)
+	assert.NoError(t, err)
+	_, err = slotRoute(16384)
+	assert.Error(t, err)
+	_, err = slotRoute(-1)
+	assert.Error(t, err)
+}
+
+func ExampleGlideClusterClient_ClusterKeySlot() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	result, err := client.ClusterKeySlot(context.Background(), "{user1000}.following")
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 3443
+}
+
+func ExampleGlideClusterClient_Topology() {
+	var client *GlideClusterClient = getExampleGlideClusterClient() // example helper function
+	topology, err := client.Topology(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(topology.IsHealthy())
+
+	// Output: true
+}
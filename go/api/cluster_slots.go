@@ -0,0 +1,189 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+)
+
+const (
+	// slotCount is the number of hash slots of a Valkey cluster.
+	slotCount              = 16384
+	slotMapRefreshInterval = 30 * time.Second
+	slotMapRefreshTimeout  = 5 * time.Second
+)
+
+// keyHashSlot returns the cluster hash slot of key. Only the hash tag is hashed when the key contains one.
+func keyHashSlot(key string) int {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	return int(crc16(key)) % slotCount
+}
+
+// crc16 implements the CRC16-CCITT (XMODEM) checksum used for cluster key hashing.
+func crc16(data string) uint16 {
+	var crc uint16
+	for i := 0; i < len(data); i++ {
+		crc ^= uint16(data[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// shardNodes holds the addresses of the nodes of a shard.
+type shardNodes struct {
+	primary  string
+	replicas []string
+}
+
+// slotMap maps every hash slot to the nodes serving it, or to nil for unassigned slots.
+type slotMap [slotCount]*shardNodes
+
+// nodes returns the addresses of all the nodes of the cluster.
+func (slots *slotMap) nodes() []string {
+	var nodes []string
+	seen := make(map[*shardNodes]struct{})
+	for _, shard := range slots {
+		if _, ok := seen[shard]; ok || shard == nil {
+			continue
+		}
+		seen[shard] = struct{}{}
+		nodes = append(nodes, shard.primary)
+		nodes = append(nodes, shard.replicas...)
+	}
+	return nodes
+}
+
+// parseClusterSlots builds a [slotMap] from the response of the CLUSTER SLOTS command.
+func parseClusterSlots(response any) (*slotMap, error) {
+	ranges, ok := response.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected CLUSTER SLOTS response type %T", response)
+	}
+	slots := &slotMap{}
+	shards := make(map[string]*shardNodes)
+	for _, item := range ranges {
+		slotRange, ok := item.([]any)
+		if !ok || len(slotRange) < 3 {
+			return nil, fmt.Errorf("unexpected CLUSTER SLOTS range %v", item)
+		}
+		start, startOk := slotRange[0].(int64)
+		end, endOk := slotRange[1].(int64)
+		if !startOk || !endOk || start < 0 || end >= slotCount || start > end {
+			return nil, fmt.Errorf("unexpected CLUSTER SLOTS range %v", item)
+		}
+		addresses := make([]string, 0, len(slotRange)-2)
+		for _, node := range slotRange[2:] {
+			address, err := parseClusterSlotsNode(node)
+			if err != nil {
+				return nil, err
+			}
+			addresses = append(addresses, address)
+		}
+		shard, ok := shards[addresses[0]]
+		if !ok {
+			shard = &shardNodes{primary: addresses[0], replicas: addresses[1:]}
+			shards[addresses[0]] = shard
+		}
+		for slot := start; slot <= end; slot++ {
+			slots[slot] = shard
+		}
+	}
+	return slots, nil
+}
+
+func parseClusterSlotsNode(node any) (string, error) {
+	fields, ok := node.([]any)
+	if !ok || len(fields) < 2 {
+		return "", fmt.Errorf("unexpected CLUSTER SLOTS node %v", node)
+	}
+	host, hostOk := fields[0].(string)
+	port, portOk := fields[1].(int64)
+	if !hostOk || !portOk {
+		return "", fmt.Errorf("unexpected CLUSTER SLOTS node %v", node)
+	}
+	return net.JoinHostPort(host, strconv.FormatInt(port, 10)), nil
+}
+
+// nodeRoute returns a route to the node with the given address, as in "10.0.0.1:6379".
+func nodeRoute(node string) (*config.ByAddressRoute, bool) {
+	host, portString, err := net.SplitHostPort(node)
+	if err != nil {
+		return nil, false
+	}
+	port, err := strconv.ParseInt(portString, 10, 32)
+	if err != nil {
+		return nil, false
+	}
+	return config.NewByAddressRoute(host, int32(port)), true
+}
+
+// clusterSlots caches the slot map of a cluster, which is refreshed in the background when it gets older than
+// slotMapRefreshInterval.
+type clusterSlots struct {
+	slots       atomic.Pointer[slotMap]
+	refreshedAt atomic.Int64
+	refreshing  atomic.Bool
+	fetch       func(ctx context.Context) (*slotMap, error)
+	now         func() time.Time
+}
+
+func newClusterSlots(fetch func(ctx context.Context) (*slotMap, error)) *clusterSlots {
+	return &clusterSlots{fetch: fetch, now: time.Now}
+}
+
+// refresh fetches the slot map of the cluster.
+func (cache *clusterSlots) refresh(ctx context.Context) {
+	defer cache.refreshing.Store(false)
+	if slots, err := cache.fetch(ctx); err == nil {
+		cache.slots.Store(slots)
+	}
+	// Failed refreshes are retried after the refresh interval as well, so that an unreachable cluster isn't polled on
+	// every command.
+	cache.refreshedAt.Store(cache.now().UnixNano())
+}
+
+// requestRefresh refreshes the slot map in the background, unless a refresh is already running.
+func (cache *clusterSlots) requestRefresh() {
+	if cache.refreshing.CompareAndSwap(false, true) {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), slotMapRefreshTimeout)
+			defer cancel()
+			cache.refresh(ctx)
+		}()
+	}
+}
+
+// load returns the current slot map, or nil if it was never fetched successfully.
+func (cache *clusterSlots) load() *slotMap {
+	if time.Duration(cache.now().UnixNano()-cache.refreshedAt.Load()) > slotMapRefreshInterval {
+		cache.requestRefresh()
+	}
+	return cache.slots.Load()
+}
+
+// shard returns the nodes serving slot, or nil if they are unknown.
+func (cache *clusterSlots) shard(slot int) *shardNodes {
+	slots := cache.load()
+	if slots == nil || slot < 0 || slot >= slotCount {
+		return nil
+	}
+	return slots[slot]
+}
@@ -0,0 +1,51 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+)
+
+func TestKeyHashSlot(t *testing.T) {
+	assert.Equal(t, 12739, keyHashSlot("123456789"))
+	assert.Equal(t, 12182, keyHashSlot("foo"))
+	assert.Equal(t, keyHashSlot("user1000"), keyHashSlot("{user1000}.following"))
+	assert.Equal(t, keyHashSlot("{user1000}.following"), keyHashSlot("{user1000}.followers"))
+	// An empty hash tag is not a hash tag.
+	assert.Equal(t, int(crc16("{}key"))%slotCount, keyHashSlot("{}key"))
+}
+
+func TestParseClusterSlots(t *testing.T) {
+	response := []any{
+		[]any{int64(0), int64(5460), []any{"10.0.0.1", int64(6379), "id1"}, []any{"10.0.0.4", int64(6379), "id4"}},
+		[]any{int64(5461), int64(16383), []any{"::1", int64(6380), "id2"}},
+	}
+	slots, err := parseClusterSlots(response)
+	assert.NoError(t, err)
+	assert.Equal(t, &shardNodes{primary: "10.0.0.1:6379", replicas: []string{"10.0.0.4:6379"}}, slots[0])
+	assert.Same(t, slots[0], slots[5460])
+	assert.Equal(t, &shardNodes{primary: "[::1]:6380", replicas: []string{}}, slots[5461])
+	assert.Same(t, slots[5461], slots[16383])
+	assert.Equal(t, []string{"10.0.0.1:6379", "10.0.0.4:6379", "[::1]:6380"}, slots.nodes())
+
+	_, err = parseClusterSlots("OK")
+	assert.Error(t, err)
+	_, err = parseClusterSlots([]any{[]any{int64(0), int64(20000), []any{"10.0.0.1", int64(6379)}}})
+	assert.Error(t, err)
+}
+
+func TestNodeRoute(t *testing.T) {
+	route, ok := nodeRoute("10.0.0.1:6379")
+	assert.True(t, ok)
+	assert.Equal(t, config.NewByAddressRoute("10.0.0.1", 6379), route)
+
+	route, ok = nodeRoute("[::1]:6380")
+	assert.True(t, ok)
+	assert.Equal(t, config.NewByAddressRoute("::1", 6380), route)
+
+	_, ok = nodeRoute("10.0.0.1")
+	assert.False(t, ok)
+}
@@ -3,9 +3,11 @@
 package api
 
 import (
-	"errors"
+	goErrors "errors"
+	"fmt"
 	"time"
 
+	"github.com/valkey-io/valkey-glide/go/api/errors"
 	"github.com/valkey-io/valkey-glide/go/protobuf"
 )
 
@@ -39,11 +41,14 @@ type ServerCredentials struct {
 	username string
 	// The password that will be used for authenticating connections to the servers.
 	password string
+	// provider provides the credentials instead of username and password, if set.
+	provider CredentialsProvider
+	state    *credentialsState
 }
 
 // NewServerCredentials returns a [ServerCredentials] struct with the given username and password.
 func NewServerCredentials(username string, password string) *ServerCredentials {
-	return &ServerCredentials{username, password}
+	return &ServerCredentials{username: username, password: password}
 }
 
 // NewServerCredentialsWithDefaultUsername returns a [ServerCredentials] struct with a default username of "default" and the
@@ -52,7 +57,17 @@ func NewServerCredentialsWithDefaultUsername(password string) *ServerCredentials
 	return &ServerCredentials{password: password}
 }
 
+// NewServerCredentialsWithProvider returns a [ServerCredentials] struct whose username and password are provided by
+// provider, when the client connects and again before the password expires. See [CredentialsProvider].
+func NewServerCredentialsWithProvider(provider CredentialsProvider) *ServerCredentials {
+	return &ServerCredentials{provider: provider, state: &credentialsState{}}
+}
+
 func (creds *ServerCredentials) toProtobuf() *protobuf.AuthenticationInfo {
+	if creds.provider != nil {
+		current, _ := creds.state.get()
+		return &protobuf.AuthenticationInfo{Username: current.Username, Password: current.Password}
+	}
 	return &protobuf.AuthenticationInfo{Username: creds.username, Password: creds.password}
 }
 
@@ -72,6 +87,10 @@ const (
 	// robin manner, prioritizing local replicas, then the local primary, and falling back to any
 	// replica or the primary if needed.
 	AzAffinityReplicaAndPrimary
+	// LowestLatency - Route the read requests to the replica with the lowest measured latency. If no replica latency was
+	// measured yet, spread the read requests between all replicas in a round-robin manner. Only supported by cluster
+	// clients, see [GlideClusterClient.NodeLatencies].
+	LowestLatency
 )
 
 func mapReadFrom(readFrom ReadFrom) protobuf.ReadFrom {
@@ -87,17 +106,27 @@ func mapReadFrom(readFrom ReadFrom) protobuf.ReadFrom {
 		return protobuf.ReadFrom_AZAffinityReplicasAndPrimary
 	}
 
+	// Reads are routed to the lowest latency replica by the client, the core routes the remaining reads to replicas.
+	if readFrom == LowestLatency {
+		return protobuf.ReadFrom_PreferReplica
+	}
+
 	return protobuf.ReadFrom_Primary
 }
 
 type baseClientConfiguration struct {
-	addresses      []NodeAddress
-	useTLS         bool
-	credentials    *ServerCredentials
-	readFrom       ReadFrom
-	requestTimeout time.Duration
-	clientName     string
-	clientAZ       string
+	addresses                 []NodeAddress
+	useTLS                    bool
+	credentials               *ServerCredentials
+	readFrom                  ReadFrom
+	requestTimeout            time.Duration
+	clientName                string
+	clientAZ                  string
+	retryPolicy               *RetryPolicy
+	hedging                   *HedgingConfig
+	connectionsPerNode        int
+	connectionBalancing       ConnectionBalancing
+	fireAndForgetErrorHandler func(error)
 }
 
 func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest, error) {
@@ -116,6 +145,24 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 		request.AuthenticationInfo = config.credentials.toProtobuf()
 	}
 
+	if config.retryPolicy != nil {
+		if err := config.retryPolicy.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.hedging != nil {
+		if err := config.hedging.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.connectionsPerNode < 0 {
+		return nil, &errors.ConfigurationError{
+			Msg: fmt.Sprintf("invalid number of connections per node %d", config.connectionsPerNode),
+		}
+	}
+
 	request.ReadFrom = mapReadFrom(config.readFrom)
 	if config.requestTimeout != 0 {
 		request.RequestTimeout = uint32(config.requestTimeout)
@@ -132,7 +179,7 @@ func (config *baseClientConfiguration) toProtobuf() (*protobuf.ConnectionRequest
 	if request.ReadFrom == protobuf.ReadFrom_AZAffinity ||
 		request.ReadFrom == protobuf.ReadFrom_AZAffinityReplicasAndPrimary {
 		if config.clientAZ == "" {
-			return nil, errors.New("client AZ must be set when using AZ affinity or AZ affinity with replicas and primary")
+			return nil, goErrors.New("client AZ must be set when using AZ affinity or AZ affinity with replicas and primary")
 		}
 	}
 
@@ -177,7 +224,9 @@ type GlideClientConfiguration struct {
 	baseClientConfiguration
 	reconnectStrategy  *BackoffStrategy
 	databaseId         int
+	dedicatedPoolSize  int
 	subscriptionConfig *StandaloneSubscriptionConfig
+	sentinel           *SentinelConfig
 	AdvancedGlideClientConfiguration
 }
 
@@ -193,9 +242,28 @@ func (config *GlideClientConfiguration) toProtobuf() (*protobuf.ConnectionReques
 		return nil, err
 	}
 	request.ClusterModeEnabled = false
+	if config.readFrom == LowestLatency {
+		return nil, &errors.ConfigurationError{Msg: "LowestLatency read from strategy is only supported in cluster mode"}
+	}
+	if config.dedicatedPoolSize < 0 {
+		return nil, &errors.ConfigurationError{Msg: fmt.Sprintf("invalid dedicated pool size %d", config.dedicatedPoolSize)}
+	}
 	if config.reconnectStrategy != nil {
 		request.ConnectionRetryStrategy = config.reconnectStrategy.toProtobuf()
 	}
+	if config.sentinel != nil {
+		if err := config.validateSentinel(); err != nil {
+			return nil, err
+		}
+		if config.sentinel.state == nil {
+			return nil, &errors.ConfigurationError{
+				Msg: fmt.Sprintf("the nodes of master %q weren't discovered from the sentinels", config.sentinel.masterName),
+			}
+		}
+		for _, address := range config.sentinel.state.get().addresses(config.readFrom) {
+			request.Addresses = append(request.Addresses, address.toProtobuf())
+		}
+	}
 
 	if config.databaseId != 0 {
 		request.DatabaseId = uint32(config.databaseId)
@@ -271,6 +339,37 @@ func (config *GlideClientConfiguration) WithClientAZ(clientAZ string) *GlideClie
 	return config
 }
 
+// WithRetryPolicy sets the [RetryPolicy] used to retry commands that failed with a transient error. Only read-only commands
+// and commands executed with a context returned by [WithRetry] are retried. If not set, commands are not retried by the
+// client.
+func (config *GlideClientConfiguration) WithRetryPolicy(policy *RetryPolicy) *GlideClientConfiguration {
+	config.retryPolicy = policy
+	return config
+}
+
+// WithHedging enables hedged reads configured by hedging: read-only commands which haven't completed within the hedging
+// delay are sent a second time, and the first response is used. If not set, commands are sent once.
+func (config *GlideClientConfiguration) WithHedging(hedging *HedgingConfig) *GlideClientConfiguration {
+	config.hedging = hedging
+	return config
+}
+
+// WithConnectionsPerNode sets the number of multiplexed connections the client keeps to every node. Commands are spread
+// between the connections, which relieves a single connection saturated by many concurrent commands. Blocking commands and
+// subscriptions use the first connection only, while [GlideClient.Select] and [GlideClient.ClientSetName] apply to every
+// connection. If not set, a single connection per node is used.
+func (config *GlideClientConfiguration) WithConnectionsPerNode(connectionsPerNode int) *GlideClientConfiguration {
+	config.connectionsPerNode = connectionsPerNode
+	return config
+}
+
+// WithConnectionBalancing sets the [ConnectionBalancing] strategy spreading the commands between the connections to a node,
+// when more than one is configured with WithConnectionsPerNode. If not set, [RoundRobin] will be used.
+func (config *GlideClientConfiguration) WithConnectionBalancing(balancing ConnectionBalancing) *GlideClientConfiguration {
+	config.connectionBalancing = balancing
+	return config
+}
+
 // WithReconnectStrategy sets the [BackoffStrategy] used to determine how and when to reconnect, in case of connection
 // failures. If not set, a default backoff strategy will be used.
 func (config *GlideClientConfiguration) WithReconnectStrategy(strategy *BackoffStrategy) *GlideClientConfiguration {
@@ -284,6 +383,20 @@ func (config *GlideClientConfiguration) WithDatabaseId(id int) *GlideClientConfi
 	return config
 }
 
+// WithDedicatedPoolSize sets the maximal number of dedicated connections checked out at the same time with
+// [GlideClient.Dedicated]. If not set, at most 4 dedicated connections are used.
+func (config *GlideClientConfiguration) WithDedicatedPoolSize(size int) *GlideClientConfiguration {
+	config.dedicatedPoolSize = size
+	return config
+}
+
+// WithFireAndForgetErrorHandler sets the handler receiving the errors of the commands sent with the [FireAndForgetClient]
+// of the client. The handler is called from a separate goroutine for every error. If not set, the errors are discarded.
+func (config *GlideClientConfiguration) WithFireAndForgetErrorHandler(handler func(error)) *GlideClientConfiguration {
+	config.fireAndForgetErrorHandler = handler
+	return config
+}
+
 // WithAdvancedConfiguration sets the advanced configuration settings for the client.
 func (config *GlideClientConfiguration) WithAdvancedConfiguration(
 	advancedConfig *AdvancedGlideClientConfiguration,
@@ -292,6 +405,15 @@ func (config *GlideClientConfiguration) WithAdvancedConfiguration(
 	return config
 }
 
+// WithSentinel configures the client to discover the primary and the replicas of a master from Valkey Sentinel, and to
+// connect to the promoted replica after a failover. The replicas are only connected to when [ReadFrom] allows reading from
+// replicas. The addresses of the nodes must not be set with [GlideClientConfiguration.WithAddress] along with a sentinel
+// configuration.
+func (config *GlideClientConfiguration) WithSentinel(sentinel *SentinelConfig) *GlideClientConfiguration {
+	config.sentinel = sentinel
+	return config
+}
+
 // WithSubscriptionConfig sets the subscription configuration for the client.
 func (config *GlideClientConfiguration) WithSubscriptionConfig(
 	subscriptionConfig *StandaloneSubscriptionConfig,
@@ -305,7 +427,9 @@ func (config *GlideClientConfiguration) WithSubscriptionConfig(
 // used.
 type GlideClusterClientConfiguration struct {
 	baseClientConfiguration
+	databaseId         int
 	subscriptionConfig *ClusterSubscriptionConfig
+	circuitBreaker     *CircuitBreakerConfig
 	AdvancedGlideClusterClientConfiguration
 }
 
@@ -325,9 +449,17 @@ func (config *GlideClusterClientConfiguration) toProtobuf() (*protobuf.Connectio
 	}
 
 	request.ClusterModeEnabled = true
+	if config.circuitBreaker != nil {
+		if err := config.circuitBreaker.validate(); err != nil {
+			return nil, err
+		}
+	}
 	if (config.AdvancedGlideClusterClientConfiguration.connectionTimeout) != 0 {
 		request.ConnectionTimeout = uint32(config.AdvancedGlideClusterClientConfiguration.connectionTimeout)
 	}
+	if config.databaseId != 0 {
+		request.DatabaseId = uint32(config.databaseId)
+	}
 	if config.subscriptionConfig != nil && len(config.subscriptionConfig.subscriptions) > 0 {
 		request.PubsubSubscriptions = config.subscriptionConfig.toProtobuf()
 	}
@@ -359,6 +491,13 @@ func (config *GlideClusterClientConfiguration) WithUseTLS(useTLS bool) *GlideClu
 	return config
 }
 
+// WithDatabaseId sets the index of the logical database selected on every node. Multiple databases are supported in
+// cluster mode from Valkey 9.0.
+func (config *GlideClusterClientConfiguration) WithDatabaseId(id int) *GlideClusterClientConfiguration {
+	config.databaseId = id
+	return config
+}
+
 // WithCredentials sets the credentials for the authentication process. If none are set, the client will not authenticate
 // itself with the server.
 func (config *GlideClusterClientConfiguration) WithCredentials(
@@ -398,6 +537,50 @@ func (config *GlideClusterClientConfiguration) WithClientAZ(clientAZ string) *Gl
 	return config
 }
 
+// WithRetryPolicy sets the [RetryPolicy] used to retry commands that failed with a transient error. Only read-only commands
+// and commands executed with a context returned by [WithRetry] are retried. If not set, commands are not retried by the
+// client.
+func (config *GlideClusterClientConfiguration) WithRetryPolicy(policy *RetryPolicy) *GlideClusterClientConfiguration {
+	config.retryPolicy = policy
+	return config
+}
+
+// WithHedging enables hedged reads configured by hedging: read-only commands which haven't completed within the hedging
+// delay are sent a second time, and the first response is used. If not set, commands are sent once.
+func (config *GlideClusterClientConfiguration) WithHedging(hedging *HedgingConfig) *GlideClusterClientConfiguration {
+	config.hedging = hedging
+	return config
+}
+
+// WithConnectionsPerNode sets the number of multiplexed connections the client keeps to every node. Commands are spread
+// between the connections, which relieves a single connection saturated by many concurrent commands. Blocking commands and
+// subscriptions use the first connection only, while [GlideClusterClient.ClientSetName] applies to every connection. If not
+// set, a single connection per node is used.
+func (config *GlideClusterClientConfiguration) WithConnectionsPerNode(
+	connectionsPerNode int,
+) *GlideClusterClientConfiguration {
+	config.connectionsPerNode = connectionsPerNode
+	return config
+}
+
+// WithConnectionBalancing sets the [ConnectionBalancing] strategy spreading the commands between the connections to a node,
+// when more than one is configured with WithConnectionsPerNode. If not set, [RoundRobin] will be used.
+func (config *GlideClusterClientConfiguration) WithConnectionBalancing(
+	balancing ConnectionBalancing,
+) *GlideClusterClientConfiguration {
+	config.connectionBalancing = balancing
+	return config
+}
+
+// WithFireAndForgetErrorHandler sets the handler receiving the errors of the commands sent with the [FireAndForgetClient]
+// of the client. The handler is called from a separate goroutine for every error. If not set, the errors are discarded.
+func (config *GlideClusterClientConfiguration) WithFireAndForgetErrorHandler(
+	handler func(error),
+) *GlideClusterClientConfiguration {
+	config.fireAndForgetErrorHandler = handler
+	return config
+}
+
 // WithAdvancedConfiguration sets the advanced configuration settings for the client.
 func (config *GlideClusterClientConfiguration) WithAdvancedConfiguration(
 	advancedConfig *AdvancedGlideClusterClientConfiguration,
@@ -414,6 +597,16 @@ func (config *GlideClusterClientConfiguration) WithSubscriptionConfig(
 	return config
 }
 
+// WithCircuitBreaker enables a per-node circuit breaker configured by circuitBreaker. When the commands sent to a node keep
+// failing, the client stops sending commands to that node for a while and fails them immediately with a
+// [errors.CircuitOpenError]. If not set, no circuit breaker is used.
+func (config *GlideClusterClientConfiguration) WithCircuitBreaker(
+	circuitBreaker *CircuitBreakerConfig,
+) *GlideClusterClientConfiguration {
+	config.circuitBreaker = circuitBreaker
+	return config
+}
+
 // Advanced configuration settings class for creating a client. Shared settings for standalone and
 // cluster clients.
 type AdvancedBaseClientConfiguration struct {
@@ -174,3 +174,10 @@ func TestConfig_AzAffinity(t *testing.T) {
 
 	assert.Equal(t, expected, result)
 }
+
+func TestGlideClusterClientConfiguration_DatabaseId(t *testing.T) {
+	request, err := NewGlideClusterClientConfiguration().WithDatabaseId(3).toProtobuf()
+	assert.NoError(t, err)
+	assert.True(t, request.ClusterModeEnabled)
+	assert.Equal(t, uint32(3), request.DatabaseId)
+}
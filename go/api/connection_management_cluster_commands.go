@@ -37,4 +37,63 @@ type ConnectionManagementClusterCommands interface {
 	ClientGetName(ctx context.Context) (ClusterValue[string], error)
 
 	ClientGetNameWithOptions(ctx context.Context, routeOptions options.RouteOption) (ClusterValue[string], error)
+
+	ClientInfo(ctx context.Context) (ClusterValue[ClientInfo], error)
+
+	ClientInfoWithOptions(ctx context.Context, routeOptions options.RouteOption) (ClusterValue[ClientInfo], error)
+
+	ClientList(ctx context.Context) (ClusterValue[[]ClientInfo], error)
+
+	ClientListWithOptions(ctx context.Context, routeOptions options.RouteOption) (ClusterValue[[]ClientInfo], error)
+
+	ClientKill(ctx context.Context, opts *options.ClientKillOptions) (int64, error)
+
+	ClientKillWithOptions(
+		ctx context.Context,
+		opts *options.ClientKillOptions,
+		routeOptions options.RouteOption,
+	) (int64, error)
+
+	ClientPause(ctx context.Context, timeoutMillis int64, mode options.ClientPauseMode) (string, error)
+
+	ClientPauseWithOptions(
+		ctx context.Context,
+		timeoutMillis int64,
+		mode options.ClientPauseMode,
+		routeOptions options.RouteOption,
+	) (string, error)
+
+	ClientUnpause(ctx context.Context) (string, error)
+
+	ClientUnpauseWithOptions(ctx context.Context, routeOptions options.RouteOption) (string, error)
+
+	ClientNoEvict(ctx context.Context, enabled bool) (string, error)
+
+	ClientNoEvictWithOptions(ctx context.Context, enabled bool, routeOptions options.RouteOption) (string, error)
+
+	ClientNoTouch(ctx context.Context, enabled bool) (string, error)
+
+	ClientNoTouchWithOptions(ctx context.Context, enabled bool, routeOptions options.RouteOption) (string, error)
+
+	ClientUnblock(ctx context.Context, clientId int64, withError bool) (bool, error)
+
+	ClientUnblockWithOptions(
+		ctx context.Context,
+		clientId int64,
+		withError bool,
+		routeOptions options.RouteOption,
+	) (bool, error)
+
+	ClientSetInfo(ctx context.Context, attribute options.ClientInfoAttribute, value string) (string, error)
+
+	ClientSetInfoWithOptions(
+		ctx context.Context,
+		attribute options.ClientInfoAttribute,
+		value string,
+		routeOptions options.RouteOption,
+	) (string, error)
+
+	ClientGetRedir(ctx context.Context) (ClusterValue[int64], error)
+
+	ClientGetRedirWithOptions(ctx context.Context, routeOptions options.RouteOption) (ClusterValue[int64], error)
 }
@@ -25,4 +25,24 @@ type ConnectionManagementCommands interface {
 	ClientGetName(ctx context.Context) (string, error)
 
 	ClientSetName(ctx context.Context, connectionName string) (string, error)
+
+	ClientInfo(ctx context.Context) (ClientInfo, error)
+
+	ClientList(ctx context.Context) ([]ClientInfo, error)
+
+	ClientKill(ctx context.Context, opts *options.ClientKillOptions) (int64, error)
+
+	ClientPause(ctx context.Context, timeoutMillis int64, mode options.ClientPauseMode) (string, error)
+
+	ClientUnpause(ctx context.Context) (string, error)
+
+	ClientNoEvict(ctx context.Context, enabled bool) (string, error)
+
+	ClientNoTouch(ctx context.Context, enabled bool) (string, error)
+
+	ClientUnblock(ctx context.Context, clientId int64, withError bool) (bool, error)
+
+	ClientSetInfo(ctx context.Context, attribute options.ClientInfoAttribute, value string) (string, error)
+
+	ClientGetRedir(ctx context.Context) (int64, error)
 }
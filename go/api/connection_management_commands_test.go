@@ -5,11 +5,61 @@ package api
 import (
 	"context"
 	"fmt"
+	"testing"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/valkey-io/valkey-glide/go/api/options"
 )
 
+func TestParseClientInfo(t *testing.T) {
+	info, err := parseClientInfo("id=7 addr=127.0.0.1:50000 laddr=127.0.0.1:6379 fd=8 name=worker age=12 idle=3 flags=N " +
+		"db=2 sub=0 psub=0 multi=-1 cmd=client|info user=default lib-name=glide lib-ver=1.0 resp=3")
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), info.Id)
+	assert.Equal(t, "127.0.0.1:50000", info.Addr)
+	assert.Equal(t, "127.0.0.1:6379", info.LocalAddr)
+	assert.Equal(t, "worker", info.Name)
+	assert.Equal(t, int64(12), info.Age)
+	assert.Equal(t, int64(3), info.Idle)
+	assert.Equal(t, "N", info.Flags)
+	assert.Equal(t, int64(2), info.Db)
+	assert.Equal(t, "client|info", info.Cmd)
+	assert.Equal(t, "default", info.User)
+	assert.Equal(t, "glide", info.LibName)
+	assert.Equal(t, "1.0", info.LibVersion)
+	assert.Equal(t, "-1", info.Fields["multi"])
+
+	clients, err := convertClientList("id=1 addr=127.0.0.1:1 name= db=0\nid=2 addr=127.0.0.1:2 name=other db=1\n")
+	require.NoError(t, err)
+	require.Len(t, clients, 2)
+	assert.Equal(t, "", clients[0].Name)
+	assert.Equal(t, "other", clients[1].Name)
+
+	_, err = parseClientInfo("id=x")
+	assert.Error(t, err)
+	_, err = parseClientInfo("id")
+	assert.Error(t, err)
+}
+
+func TestClientKillOptions_ToArgs(t *testing.T) {
+	args, err := options.NewClientKillOptions().
+		SetId(5).
+		SetAddr("127.0.0.1:50000").
+		SetUser("reader").
+		SetMaxAge(60).
+		SetSkipMe(false).
+		ToArgs()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ID", "5", "ADDR", "127.0.0.1:50000", "USER", "reader", "MAXAGE", "60", "SKIPME", "NO"}, args)
+
+	_, err = options.NewClientKillOptions().ToArgs()
+	assert.Error(t, err)
+	_, err = (*options.ClientKillOptions)(nil).ToArgs()
+	assert.Error(t, err)
+}
+
 func ExampleGlideClient_Ping() {
 	var client *GlideClient = getExampleGlideClient() // example helper function
 	result, err := client.Ping(context.Background())
@@ -79,3 +129,19 @@ func ExampleGlideClient_ClientGetName() {
 
 	// Output: true
 }
+
+func ExampleGlideClient_ClientInfo() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	name := "example-" + uuid.NewString()
+	_, err := client.ClientSetName(context.Background(), name)
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	result, err := client.ClientInfo(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result.Name == name)
+
+	// Output: true
+}
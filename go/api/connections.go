@@ -0,0 +1,146 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+// ConnectionBalancing represents the strategy selecting the connection used by a command, when the client keeps several
+// connections per node. See [GlideClientConfiguration.WithConnectionsPerNode].
+type ConnectionBalancing int
+
+const (
+	// RoundRobin - Spread the commands between the connections in a round-robin manner.
+	RoundRobin ConnectionBalancing = iota
+	// LeastInflight - Send every command on the connection with the fewest commands awaiting their response.
+	LeastInflight
+)
+
+// connectionStateRequestTypes are the request types which change the state of the connection they're sent on. They are
+// sent on every connection of the client, so that all the connections share the same state.
+var connectionStateRequestTypes = map[protobuf.RequestType]struct{}{
+	protobuf.RequestType_Select:        {},
+	protobuf.RequestType_ClientSetName: {},
+}
+
+// connectionScopedRequestTypes are the request types which return the state of the connection they're sent on. They are
+// sent on the first connection of the client, like blocking commands, so that they describe the connection of the
+// blocking commands and of the subscriptions.
+var connectionScopedRequestTypes = map[protobuf.RequestType]struct{}{
+	protobuf.RequestType_ClientId:      {},
+	protobuf.RequestType_ClientGetName: {},
+}
+
+// connections holds the connections that a client opened in addition to its own one, and spreads the commands between
+// them. Every connection is a separate core client, multiplexing its commands on one connection per node.
+type connections struct {
+	extra []*baseClient
+	// config configures the extra connections, which are connected again with it after a failover.
+	config    clientConfiguration
+	balancing ConnectionBalancing
+	// inflight counts the commands awaiting their response on every connection, starting with the client's own one.
+	inflight []atomic.Int64
+	next     atomic.Uint64
+}
+
+func newConnections(extra []*baseClient, balancing ConnectionBalancing) *connections {
+	return &connections{extra: extra, balancing: balancing, inflight: make([]atomic.Int64, len(extra)+1)}
+}
+
+// openConnections opens count-1 connections configured by config, in addition to the connection of a client.
+func openConnections(config clientConfiguration, count int, balancing ConnectionBalancing) (*connections, error) {
+	extra := make([]*baseClient, 0, count-1)
+	for len(extra) < count-1 {
+		connection, err := createClient(config)
+		if err != nil {
+			for _, connection := range extra {
+				connection.Close()
+			}
+			return nil, err
+		}
+		extra = append(extra, connection)
+	}
+	connections := newConnections(extra, balancing)
+	connections.config = config
+	return connections, nil
+}
+
+// acquire returns the index of the connection to send a command on, where 0 is the client's own connection. The command is
+// counted as in flight until release is called.
+func (connections *connections) acquire() int {
+	count := uint64(len(connections.inflight))
+	index := int(connections.next.Add(1) % count)
+	if connections.balancing == LeastInflight {
+		// Starting from the next connection in turn spreads the commands between connections with the same load.
+		start, least := index, connections.inflight[index].Load()
+		for i := 1; i < len(connections.inflight); i++ {
+			candidate := (start + i) % len(connections.inflight)
+			if inflight := connections.inflight[candidate].Load(); inflight < least {
+				index, least = candidate, inflight
+			}
+		}
+	}
+	connections.inflight[index].Add(1)
+	return index
+}
+
+// release marks a command sent on the connection at index as completed.
+func (connections *connections) release(index int) {
+	connections.inflight[index].Add(-1)
+}
+
+// send sends a single attempt of the command on one of the connections of client. Blocking commands keep using the
+// client's own connection, on which the connection ID used to unblock them is fetched, and the subscriptions of the client
+// are only established on that connection.
+func (connections *connections) send(
+	ctx context.Context,
+	client *baseClient,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+) (*C.struct_CommandResponse, error) {
+	if _, ok := connectionStateRequestTypes[protobuf.RequestType(requestType)]; ok {
+		response, err := client.submitCommand(ctx, requestType, args, route)
+		if err != nil {
+			return nil, err
+		}
+		for _, connection := range connections.extra {
+			extraResponse, err := connection.submitCommand(ctx, requestType, args, route)
+			if err != nil {
+				C.free_command_response(response)
+				return nil, err
+			}
+			C.free_command_response(extraResponse)
+		}
+		return response, nil
+	}
+	if _, ok := connectionScopedRequestTypes[protobuf.RequestType(requestType)]; ok {
+		return client.submitCommand(ctx, requestType, args, route)
+	}
+	if _, ok := blockingCommandKey(protobuf.RequestType(requestType), args); ok {
+		return client.submitCommand(ctx, requestType, args, route)
+	}
+
+	index := connections.acquire()
+	defer connections.release(index)
+	connection := client
+	if index > 0 {
+		connection = connections.extra[index-1]
+	}
+	return connection.submitCommand(ctx, requestType, args, route)
+}
+
+// close closes the connections opened in addition to the connection of the client.
+func (connections *connections) close() {
+	for _, connection := range connections.extra {
+		connection.Close()
+	}
+}
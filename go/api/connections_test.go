@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func TestConnections_RoundRobin(t *testing.T) {
+	connections := newConnections(make([]*baseClient, 2), RoundRobin)
+
+	var indexes []int
+	for i := 0; i < 6; i++ {
+		indexes = append(indexes, connections.acquire())
+	}
+	assert.Equal(t, []int{1, 2, 0, 1, 2, 0}, indexes)
+}
+
+func TestConnections_LeastInflight(t *testing.T) {
+	connections := newConnections(make([]*baseClient, 2), LeastInflight)
+
+	// Connections with the same load are used in turn.
+	first, second, third := connections.acquire(), connections.acquire(), connections.acquire()
+	assert.ElementsMatch(t, []int{0, 1, 2}, []int{first, second, third})
+
+	// The connection with the fewest commands in flight is used, whichever connection is next in turn.
+	connections.release(second)
+	for i := 0; i < 3; i++ {
+		index := connections.acquire()
+		assert.Equal(t, second, index)
+		connections.release(index)
+	}
+	assert.Equal(t, int64(1), connections.inflight[first].Load())
+	assert.Equal(t, int64(0), connections.inflight[second].Load())
+}
+
+func TestConfig_InvalidConnectionsPerNode(t *testing.T) {
+	_, err := NewGlideClientConfiguration().WithConnectionsPerNode(-1).toProtobuf()
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+
+	_, err = NewGlideClusterClientConfiguration().WithConnectionsPerNode(-1).toProtobuf()
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+}
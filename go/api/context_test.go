@@ -0,0 +1,40 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func TestDeadlineTimeout(t *testing.T) {
+	assert.Equal(t, uint32(0), deadlineTimeout(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1500*time.Millisecond)
+	defer cancel()
+	timeout := deadlineTimeout(ctx)
+	assert.LessOrEqual(t, timeout, uint32(1500))
+	assert.Greater(t, timeout, uint32(1400))
+
+	// A deadline in the past still leads to a timeout, since 0 means the client's request timeout.
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	assert.Equal(t, uint32(1), deadlineTimeout(expired))
+}
+
+func TestContextError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(t, context.Canceled, contextError(ctx))
+
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+	err := contextError(expired)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorAs(t, err, new(*errors.TimeoutError))
+	assert.Contains(t, err.Error(), "context deadline exceeded")
+}
@@ -0,0 +1,131 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Credentials are the credentials returned by a [CredentialsProvider].
+type Credentials struct {
+	// Username is the username used when connecting. If empty, "default" is used.
+	Username string
+	// Password is the password, or the authentication token, used when connecting.
+	Password string
+	// ExpiresAt is the time the password expires at. The client fetches new credentials before then. The zero time means
+	// the password doesn't expire, and isn't refreshed.
+	ExpiresAt time.Time
+}
+
+// CredentialsProvider provides the credentials of a client, such as short-lived authentication tokens. The client calls
+// Credentials when it connects, and again before the password expires, to apply the new password to its connections
+// through [BaseClient.UpdateConnectionPassword]. Only the password is updated on the connections already established: a
+// new username is used by the connections established afterward.
+//
+// Credentials may be called concurrently by the clients sharing the provider.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Credentials, error)
+}
+
+// CredentialsProviderFunc is a function implementing [CredentialsProvider].
+type CredentialsProviderFunc func(ctx context.Context) (Credentials, error)
+
+// Credentials calls f.
+func (f CredentialsProviderFunc) Credentials(ctx context.Context) (Credentials, error) {
+	return f(ctx)
+}
+
+const (
+	// credentialsRefreshRatio is the part of the lifetime of a password after which it's refreshed.
+	credentialsRefreshRatio = 0.8
+	// credentialsRetryInterval is the time to wait before fetching credentials again after a failure.
+	credentialsRetryInterval = 5 * time.Second
+	// credentialsTimeout bounds the time taken to fetch and apply credentials in the background.
+	credentialsTimeout = 30 * time.Second
+)
+
+// credentialsState holds the latest credentials returned by the provider of a [ServerCredentials], shared by all the
+// connections configured with it.
+type credentialsState struct {
+	mu        sync.Mutex
+	current   Credentials
+	fetchedAt time.Time
+}
+
+// fetch fetches new credentials from provider, and stores them as the current credentials.
+func (state *credentialsState) fetch(ctx context.Context, provider CredentialsProvider) (Credentials, error) {
+	fetchedAt := time.Now()
+	credentials, err := provider.Credentials(ctx)
+	if err != nil {
+		return Credentials{}, err
+	}
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.current, state.fetchedAt = credentials, fetchedAt
+	return credentials, nil
+}
+
+func (state *credentialsState) get() (Credentials, time.Time) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	return state.current, state.fetchedAt
+}
+
+// credentialsRefreshDelay returns the time to wait from now before refreshing credentials fetched at fetchedAt, once
+// credentialsRefreshRatio of their lifetime has elapsed. Returns a negative duration for credentials which don't expire.
+func credentialsRefreshDelay(credentials Credentials, fetchedAt time.Time, now time.Time) time.Duration {
+	if credentials.ExpiresAt.IsZero() {
+		return -1
+	}
+	lifetime := credentials.ExpiresAt.Sub(fetchedAt)
+	refreshAt := fetchedAt.Add(time.Duration(float64(lifetime) * credentialsRefreshRatio))
+	return max(refreshAt.Sub(now), 0)
+}
+
+// connectCredentials fetches the credentials used to connect a client configured with credentials, if they come from a
+// provider.
+func connectCredentials(ctx context.Context, credentials *ServerCredentials) error {
+	if credentials == nil || credentials.provider == nil {
+		return nil
+	}
+	_, err := credentials.state.fetch(ctx, credentials.provider)
+	return err
+}
+
+// refreshCredentials refreshes the credentials of the client before they expire, until the client is closed. The new
+// passwords are applied to the connections of the client, including its separate connections.
+func (client *baseClient) refreshCredentials(credentials *ServerCredentials) {
+	current, fetchedAt := credentials.state.get()
+	delay := credentialsRefreshDelay(current, fetchedAt, time.Now())
+	for delay >= 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-client.closed:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), credentialsTimeout)
+		current, err := credentials.state.fetch(ctx, credentials.provider)
+		if err == nil {
+			err = client.applyPassword(ctx, current.Password)
+		}
+		cancel()
+		if err != nil {
+			// The current password may still be valid for a while, the refresh is retried until it succeeds.
+			delay = credentialsRetryInterval
+			continue
+		}
+		current, fetchedAt = credentials.state.get()
+		delay = credentialsRefreshDelay(current, fetchedAt, time.Now())
+	}
+}
+
+// applyPassword updates the password of all the connections of the client, authenticating them with it right away.
+func (client *baseClient) applyPassword(ctx context.Context, password string) error {
+	_, err := client.UpdateConnectionPassword(ctx, password, true)
+	return err
+}
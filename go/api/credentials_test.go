@@ -0,0 +1,45 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	goErrors "errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialsRefreshDelay(t *testing.T) {
+	fetchedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	credentials := Credentials{Password: "token", ExpiresAt: fetchedAt.Add(15 * time.Minute)}
+
+	assert.Equal(t, 12*time.Minute, credentialsRefreshDelay(credentials, fetchedAt, fetchedAt))
+	assert.Equal(t, 2*time.Minute, credentialsRefreshDelay(credentials, fetchedAt, fetchedAt.Add(10*time.Minute)))
+	assert.Zero(t, credentialsRefreshDelay(credentials, fetchedAt, fetchedAt.Add(20*time.Minute)))
+	assert.Negative(t, credentialsRefreshDelay(Credentials{Password: "password"}, fetchedAt, fetchedAt))
+}
+
+func TestServerCredentials_Provider(t *testing.T) {
+	calls := 0
+	credentials := NewServerCredentialsWithProvider(CredentialsProviderFunc(func(ctx context.Context) (Credentials, error) {
+		calls++
+		if calls > 1 {
+			return Credentials{}, goErrors.New("unavailable")
+		}
+		return Credentials{Username: "user", Password: "token", ExpiresAt: time.Now().Add(time.Minute)}, nil
+	}))
+
+	assert.NoError(t, connectCredentials(context.Background(), credentials))
+	auth := credentials.toProtobuf()
+	assert.Equal(t, "user", auth.Username)
+	assert.Equal(t, "token", auth.Password)
+
+	// A failure keeps the current credentials.
+	assert.Error(t, connectCredentials(context.Background(), credentials))
+	assert.Equal(t, "token", credentials.toProtobuf().Password)
+
+	assert.NoError(t, connectCredentials(context.Background(), NewServerCredentials("user", "password")))
+	assert.NoError(t, connectCredentials(context.Background(), nil))
+}
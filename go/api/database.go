@@ -0,0 +1,80 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+	"github.com/valkey-io/valkey-glide/go/utils"
+)
+
+// selectedDatabaseConfig configures the separate connections of a client, which connect to the database selected by the
+// client at the time they are opened, rather than to the database of the configuration.
+type selectedDatabaseConfig struct {
+	clientConfiguration
+	database *atomic.Int64
+}
+
+func (config selectedDatabaseConfig) toProtobuf() (*protobuf.ConnectionRequest, error) {
+	request, err := config.clientConfiguration.toProtobuf()
+	if err != nil {
+		return nil, err
+	}
+	request.DatabaseId = uint32(config.database.Load())
+	return request, nil
+}
+
+// followDatabase returns a configuration opening connections to the database selected by the client.
+func (client *baseClient) followDatabase(config clientConfiguration) clientConfiguration {
+	return selectedDatabaseConfig{clientConfiguration: config, database: &client.database}
+}
+
+// selectDatabase selects the database on the connection of the client and on its additional connections. The separate
+// connections opened later connect to it as well, and the core selects it again when it reconnects. When a connection
+// fails to select it, the connections which already selected it select the previous database again.
+func (client *baseClient) selectDatabase(ctx context.Context, index int64) (string, error) {
+	previous := client.database.Swap(index)
+	if err := selectOn(ctx, client, index); err != nil {
+		// The database is unchanged, unless it was selected again meanwhile.
+		client.database.CompareAndSwap(index, previous)
+		return DefaultStringResponse, err
+	}
+	if client.connections == nil {
+		return OK, nil
+	}
+	for i, connection := range client.connections.extra {
+		if err := selectOn(ctx, connection, index); err != nil {
+			client.rollbackSelect(ctx, client.connections.extra[:i], index, previous)
+			return DefaultStringResponse, err
+		}
+	}
+	return OK, nil
+}
+
+// rollbackSelect selects the previous database again on the connection of the client and on the given additional
+// connections, after another connection failed to select index. It runs even if ctx was canceled, so that the
+// connections of the client don't stay on different databases.
+func (client *baseClient) rollbackSelect(ctx context.Context, switched []*baseClient, index int64, previous int64) {
+	ctx = context.WithoutCancel(ctx)
+	if !client.database.CompareAndSwap(index, previous) {
+		// The database was selected again meanwhile, which selected it on every connection.
+		return
+	}
+	for _, connection := range append([]*baseClient{client}, switched...) {
+		_ = selectOn(ctx, connection, previous)
+	}
+}
+
+func selectOn(ctx context.Context, connection *baseClient, index int64) error {
+	result, err := connection.submitCommand(ctx, C.Select, []string{utils.IntToString(index)}, nil)
+	if err != nil {
+		return err
+	}
+	_, err = handleOkResponse(result)
+	return err
+}
@@ -0,0 +1,26 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFollowDatabase(t *testing.T) {
+	client := &baseClient{}
+	client.database.Store(2)
+	config := client.followDatabase(NewGlideClusterClientConfiguration().WithDatabaseId(1))
+
+	request, err := config.toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(2), request.DatabaseId)
+
+	// The connections opened after a database is selected connect to it.
+	client.database.Store(5)
+	request, err = config.toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(5), request.DatabaseId)
+	assert.True(t, request.ClusterModeEnabled)
+}
@@ -0,0 +1,204 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	goErrors "errors"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+const (
+	defaultDedicatedPoolSize = 4
+	// dedicatedResetTimeout bounds the time spent resetting the state of a dedicated connection returned to its pool.
+	dedicatedResetTimeout = 5 * time.Second
+)
+
+// DedicatedClient is a [GlideClient] using a connection of its own, checked out with [GlideClient.Dedicated]. Commands
+// sent through a DedicatedClient don't share their connection with the commands of the parent client, so a blocking command
+// doesn't delay other traffic, and per-connection state such as the selected database, WATCH, MULTI or CLIENT TRACKING
+// doesn't leak to other callers.
+//
+// A DedicatedClient talks to the primary only, and doesn't use the retry policy and the hedging configured for its parent
+// client. It must be closed to return the connection to the pool, and must not be used afterwards.
+type DedicatedClient struct {
+	*GlideClient
+	pool      *dedicatedPool
+	closeOnce sync.Once
+}
+
+// Dedicated checks out a dedicated connection from the pool of the client. When all the connections of the pool, sized with
+// [GlideClientConfiguration.WithDedicatedPoolSize], are checked out, Dedicated waits until one is returned or ctx is done.
+//
+// For example:
+//
+//	dedicated, err := client.Dedicated(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	defer dedicated.Close()
+//	result, err := dedicated.BLPop(ctx, []string{"queue"}, 30)
+func (client *GlideClient) Dedicated(ctx context.Context) (*DedicatedClient, error) {
+	if client.dedicated == nil {
+		return nil, &errors.RequestError{Msg: "Dedicated connections can't be checked out from a dedicated client"}
+	}
+	connection, err := client.dedicated.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &DedicatedClient{GlideClient: &GlideClient{connection}, pool: client.dedicated}, nil
+}
+
+// Close resets the state of the connection, and returns it to the pool of the parent client. The connection is closed
+// instead when its state can't be reset.
+func (client *DedicatedClient) Close() {
+	client.closeOnce.Do(func() {
+		client.pool.release(client.baseClient, client.reset() == nil)
+	})
+}
+
+// reset discards the pending transaction and the watched keys of the connection, selects the configured database, and
+// turns client tracking off.
+func (client *DedicatedClient) reset() error {
+	ctx, cancel := context.WithTimeout(context.Background(), dedicatedResetTimeout)
+	defer cancel()
+	// DISCARD fails when no transaction is pending.
+	if _, err := client.CustomCommand(ctx, []string{"DISCARD"}); err != nil && !goErrors.As(err, new(*errors.RequestError)) {
+		return err
+	}
+	if _, err := client.CustomCommand(ctx, []string{"UNWATCH"}); err != nil {
+		return err
+	}
+	if _, err := client.Select(ctx, int64(client.pool.databaseId)); err != nil {
+		return err
+	}
+	_, err := client.CustomCommand(ctx, []string{"CLIENT", "TRACKING", "OFF"})
+	return err
+}
+
+// dedicatedPool holds the dedicated connections of a client, and bounds the number of connections checked out at the same
+// time.
+type dedicatedPool struct {
+	connect func() (*baseClient, error)
+	// databaseId is the database selected by the connections when they are returned to the pool.
+	databaseId int
+	// slots holds a value for every checked out connection.
+	slots  chan struct{}
+	closed <-chan struct{}
+
+	mu   sync.Mutex
+	idle []*baseClient
+	done bool
+	// generation is incremented when the pool is reset. The connections checked out from an earlier generation are closed
+	// when released.
+	generation uint64
+	checkedOut map[*baseClient]uint64
+}
+
+func newDedicatedPool(
+	size int,
+	databaseId int,
+	closed <-chan struct{},
+	connect func() (*baseClient, error),
+) *dedicatedPool {
+	return &dedicatedPool{
+		connect:    connect,
+		databaseId: databaseId,
+		slots:      make(chan struct{}, size),
+		closed:     closed,
+		checkedOut: make(map[*baseClient]uint64),
+	}
+}
+
+// acquire returns an idle connection, or a new connection when none is idle, waiting while the pool is exhausted.
+func (pool *dedicatedPool) acquire(ctx context.Context) (*baseClient, error) {
+	select {
+	case pool.slots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, contextError(ctx)
+	case <-pool.closed:
+		return nil, &errors.ClosingError{Msg: "Dedicated failed. The client is closed."}
+	}
+	pool.mu.Lock()
+	if pool.done {
+		pool.mu.Unlock()
+		<-pool.slots
+		return nil, &errors.ClosingError{Msg: "Dedicated failed. The client is closed."}
+	}
+	generation := pool.generation
+	if n := len(pool.idle); n > 0 {
+		connection := pool.idle[n-1]
+		pool.idle = pool.idle[:n-1]
+		pool.checkedOut[connection] = generation
+		pool.mu.Unlock()
+		return connection, nil
+	}
+	pool.mu.Unlock()
+	connection, err := pool.connect()
+	if err != nil {
+		<-pool.slots
+		return nil, err
+	}
+	pool.mu.Lock()
+	pool.checkedOut[connection] = generation
+	pool.mu.Unlock()
+	return connection, nil
+}
+
+// release returns a checked out connection to the pool, or closes it when it isn't reusable or the pool is closed.
+func (pool *dedicatedPool) release(connection *baseClient, reusable bool) {
+	pool.mu.Lock()
+	generation := pool.checkedOut[connection]
+	delete(pool.checkedOut, connection)
+	if reusable && !pool.done && generation == pool.generation {
+		pool.idle = append(pool.idle, connection)
+		connection = nil
+	}
+	pool.mu.Unlock()
+	if connection != nil {
+		connection.Close()
+	}
+	<-pool.slots
+}
+
+// updatePassword updates the password of the idle connections. The connections checked out keep their password, and the
+// connections established afterward use the current credentials of the client.
+func (pool *dedicatedPool) updatePassword(ctx context.Context, password string, immediateAuth bool) error {
+	pool.mu.Lock()
+	idle := append([]*baseClient(nil), pool.idle...)
+	pool.mu.Unlock()
+	for _, connection := range idle {
+		if _, err := connection.submitConnectionPasswordUpdate(ctx, password, immediateAuth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reset closes the idle connections, and the connections checked out when they are released, so that the connections
+// checked out afterward connect to the new primary after a failover.
+func (pool *dedicatedPool) reset() {
+	pool.mu.Lock()
+	idle := pool.idle
+	pool.idle = nil
+	pool.generation++
+	pool.mu.Unlock()
+	for _, connection := range idle {
+		connection.Close()
+	}
+}
+
+// close closes the idle connections. Connections checked out are closed when released.
+func (pool *dedicatedPool) close() {
+	pool.mu.Lock()
+	idle := pool.idle
+	pool.idle = nil
+	pool.done = true
+	pool.mu.Unlock()
+	for _, connection := range idle {
+		connection.Close()
+	}
+}
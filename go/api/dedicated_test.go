@@ -0,0 +1,77 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func TestDedicatedPool(t *testing.T) {
+	connected := 0
+	closed := make(chan struct{})
+	pool := newDedicatedPool(2, 0, closed, func() (*baseClient, error) {
+		connected++
+		return &baseClient{}, nil
+	})
+
+	first, err := pool.acquire(context.Background())
+	assert.NoError(t, err)
+	second, err := pool.acquire(context.Background())
+	assert.NoError(t, err)
+	assert.NotSame(t, first, second)
+
+	// The pool is exhausted until a connection is released.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = pool.acquire(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	pool.release(first, true)
+	third, err := pool.acquire(context.Background())
+	assert.NoError(t, err)
+	assert.Same(t, first, third)
+	assert.Equal(t, 2, connected)
+
+	// Connections which aren't reusable are replaced.
+	pool.release(second, false)
+	fourth, err := pool.acquire(context.Background())
+	assert.NoError(t, err)
+	assert.NotSame(t, second, fourth)
+	assert.Equal(t, 3, connected)
+
+	pool.release(third, true)
+	pool.close()
+	close(closed)
+	assert.Empty(t, pool.idle)
+	_, err = pool.acquire(context.Background())
+	assert.ErrorAs(t, err, new(*errors.ClosingError))
+}
+
+func TestDedicatedPool_Reset(t *testing.T) {
+	pool := newDedicatedPool(2, 0, make(chan struct{}), func() (*baseClient, error) {
+		return &baseClient{}, nil
+	})
+	idle, err := pool.acquire(context.Background())
+	assert.NoError(t, err)
+	checkedOut, err := pool.acquire(context.Background())
+	assert.NoError(t, err)
+	pool.release(idle, true)
+
+	// After a reset, neither the idle connections nor the connections checked out before are reused.
+	pool.reset()
+	assert.Empty(t, pool.idle)
+	pool.release(checkedOut, true)
+	assert.Empty(t, pool.idle)
+
+	connection, err := pool.acquire(context.Background())
+	assert.NoError(t, err)
+	assert.NotSame(t, idle, connection)
+	assert.NotSame(t, checkedOut, connection)
+	pool.release(connection, true)
+	assert.Len(t, pool.idle, 1)
+}
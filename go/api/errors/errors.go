@@ -5,21 +5,44 @@ package errors
 // #include "../../lib.h"
 import "C"
 
+import "fmt"
+
 // ConnectionError is a client error that occurs when there is an error while connecting or when a connection
 // disconnects.
 type ConnectionError struct {
 	Msg string
+	// Cause is the underlying error, if any.
+	Cause error
 }
 
 func (e *ConnectionError) Error() string { return e.Msg }
 
+func (e *ConnectionError) Unwrap() error { return e.Cause }
+
 // RequestError is a client error that occurs when an error is reported during a request.
+//
+// Errors reported by the server with a specific error code, such as WRONGTYPE or NOSCRIPT, have a non-empty
+// [RequestError.Code]. Use [errors.Is] with the sentinel values of this package to check for a specific code, for example:
+//
+//	if errors.Is(err, glideErrors.ErrWrongType) { ... }
 type RequestError struct {
-	Msg string
+	Msg  string
+	code string
 }
 
 func (e *RequestError) Error() string { return e.Msg }
 
+// Code returns the error code sent by the server, for example "WRONGTYPE". It is empty for errors with the generic ERR
+// code and for errors that can't be classified.
+func (e *RequestError) Code() string { return e.code }
+
+// Is reports whether target is a [RequestError] with the same non-empty code. This makes the sentinel values usable with
+// [errors.Is].
+func (e *RequestError) Is(target error) bool {
+	requestErr, ok := target.(*RequestError)
+	return ok && requestErr.code != "" && requestErr.code == e.code
+}
+
 // ExecAbortError is a client error that occurs when a transaction is aborted.
 type ExecAbortError struct {
 	msg string
@@ -27,44 +50,105 @@ type ExecAbortError struct {
 
 func (e *ExecAbortError) Error() string { return e.msg }
 
+// NewExecAbortError returns an [ExecAbortError] with the given message.
+func NewExecAbortError(msg string) *ExecAbortError { return &ExecAbortError{msg} }
+
 // TimeoutError is a client error that occurs when a request times out.
 type TimeoutError struct {
-	msg string
+	msg   string
+	cause error
 }
 
 func (e *TimeoutError) Error() string { return e.msg }
 
+func (e *TimeoutError) Unwrap() error { return e.cause }
+
+// NewTimeoutError returns a [TimeoutError] with the given message.
+func NewTimeoutError(msg string) *TimeoutError { return &TimeoutError{msg: msg} }
+
+// WrapTimeoutError returns a [TimeoutError] with the given message, which unwraps to cause.
+func WrapTimeoutError(msg string, cause error) *TimeoutError {
+	return &TimeoutError{msg: msg, cause: cause}
+}
+
 // DisconnectError is a client error that indicates a connection problem between Glide and server.
 type DisconnectError struct {
-	msg string
+	msg   string
+	cause error
 }
 
 func (e *DisconnectError) Error() string { return e.msg }
 
+func (e *DisconnectError) Unwrap() error { return e.cause }
+
+// NewDisconnectError returns a [DisconnectError] with the given message.
+func NewDisconnectError(msg string) *DisconnectError { return &DisconnectError{msg: msg} }
+
+// WrapDisconnectError returns a [DisconnectError] with the given message, which unwraps to cause.
+func WrapDisconnectError(msg string, cause error) *DisconnectError {
+	return &DisconnectError{msg: msg, cause: cause}
+}
+
 // ClosingError is a client error that indicates that the client has closed and is no longer usable.
 type ClosingError struct {
 	Msg string
+	// Cause is the underlying error, if any.
+	Cause error
 }
 
 func (e *ClosingError) Error() string { return e.Msg }
 
+func (e *ClosingError) Unwrap() error { return e.Cause }
+
+// RetryError is returned when a command was attempted more than once according to the client's retry policy and the last
+// attempt failed. It unwraps to the error of the last attempt, so [errors.As] and [errors.Is] match the underlying error.
+type RetryError struct {
+	// Attempts is the number of times the command was sent.
+	Attempts int
+	// Err is the error returned by the last attempt.
+	Err error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%s (after %d attempts)", e.Err.Error(), e.Attempts)
+}
+
+func (e *RetryError) Unwrap() error { return e.Err }
+
+// CircuitOpenError is a client error that occurs when a command is rejected without being sent, because the circuit
+// breaker of the node serving it is open.
+type CircuitOpenError struct {
+	// Node is the address of the node, as in "10.0.0.1:6379".
+	Node string
+}
+
+func (e *CircuitOpenError) Error() string { return "Circuit breaker is open for node " + e.Node }
+
 // ConfigurationError is a client error that occurs when there is an issue with client configuration.
 type ConfigurationError struct {
 	Msg string
+	// Field is the configuration field with an invalid value, when the error is about a single field.
+	Field string
 }
 
-func (e *ConfigurationError) Error() string { return e.Msg }
+func (e *ConfigurationError) Error() string {
+	if e.Field != "" {
+		return e.Field + ": " + e.Msg
+	}
+	return e.Msg
+}
 
-// GoError converts a C error type to a corresponding Go error.
+// GoError converts a C error type to a corresponding Go error. Request errors reported by the server with a specific error
+// code are returned as a [RequestError] with its [RequestError.Code] set.
 func GoError(cErrorType uint32, errorMessage string) error {
 	switch cErrorType {
 	case C.ExecAbort:
-		return &ExecAbortError{errorMessage}
+		return &ExecAbortError{msg: errorMessage}
 	case C.Timeout:
-		return &TimeoutError{errorMessage}
+		return &TimeoutError{msg: errorMessage}
 	case C.Disconnect:
-		return &DisconnectError{errorMessage}
+		return &DisconnectError{msg: errorMessage}
 	default:
-		return &RequestError{errorMessage}
+		return &RequestError{Msg: errorMessage, code: parseServerErrorCode(errorMessage)}
 	}
 }
@@ -0,0 +1,87 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package errors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoError_ExtensionServerError(t *testing.T) {
+	err := GoError(0, "WRONGTYPE: Operation against a key holding the wrong kind of value")
+
+	var requestErr *RequestError
+	assert.True(t, errors.As(err, &requestErr))
+	assert.Equal(t, "WRONGTYPE", requestErr.Code())
+	assert.Equal(t, "WRONGTYPE: Operation against a key holding the wrong kind of value", err.Error())
+	assert.ErrorIs(t, err, ErrWrongType)
+	assert.NotErrorIs(t, err, ErrNoScript)
+}
+
+func TestGoError_KnownServerError(t *testing.T) {
+	err := GoError(0, "An error was signalled by the server: - ReadOnly: You can't write against a read only replica.")
+	assert.ErrorIs(t, err, ErrReadOnly)
+
+	err = GoError(0, "An error was signalled by the server:- NoScriptError")
+	assert.ErrorIs(t, err, ErrNoScript)
+
+	err = GoError(0, "An error was signalled by the server: - CrossSlot: Keys in request don't hash to the same slot")
+	assert.ErrorIs(t, err, ErrCrossSlot)
+}
+
+func TestGoError_RequestErrorFallback(t *testing.T) {
+	for _, msg := range []string{
+		"An error was signalled by the server: - ResponseError: unknown command 'FOO'",
+		"Received a response of an unexpected type",
+		"",
+		"MYMODULE: unknown subcommand",
+		"CUSTOM: error returned by a script",
+	} {
+		err := GoError(0, msg)
+		assert.Equal(t, &RequestError{Msg: msg}, err, msg)
+		assert.NotErrorIs(t, err, &RequestError{}, msg)
+	}
+}
+
+func TestRequestError_Code(t *testing.T) {
+	code := func(msg string) string { return GoError(0, msg).(*RequestError).Code() }
+	assert.Equal(t, "BUSYGROUP", code("BUSYGROUP: Consumer Group name already exists"))
+	assert.Equal(t, "READONLY", code("An error was signalled by the server: - ReadOnly: You can't write against a replica."))
+	assert.Empty(t, code("An error was signalled by the server: - ResponseError: unknown command 'FOO'"))
+	assert.Empty(t, (&RequestError{Msg: "failed"}).Code())
+	assert.Equal(t, "WRONGTYPE", ErrWrongType.Code())
+}
+
+func TestGoError_ClassifiedCodes(t *testing.T) {
+	sentinels := map[string]error{
+		"BUSYGROUP: Consumer Group name already exists":                           ErrBusyGroup,
+		"NOGROUP: No such key 'stream' or consumer group 'group'":                 ErrNoGroup,
+		"OOM: command not allowed when used memory > 'maxmemory'.":                ErrOutOfMemory,
+		"BUSY: Valkey is busy running a script.":                                  ErrBusy,
+		"NOPERM: User default has no permissions to run the 'get' command":        ErrNoPerm,
+		"WRONGPASS: invalid username-password pair or user is disabled.":          ErrWrongPass,
+		"An error was signalled by the server: - TryAgain: Multiple keys request": ErrTryAgain,
+	}
+	for msg, sentinel := range sentinels {
+		assert.ErrorIs(t, GoError(0, msg), sentinel, msg)
+	}
+}
+
+func TestGenericErrors_Unwrap(t *testing.T) {
+	assert.ErrorIs(t, WrapTimeoutError("timed out", context.DeadlineExceeded), context.DeadlineExceeded)
+	assert.ErrorIs(t, WrapDisconnectError("disconnected", context.Canceled), context.Canceled)
+	assert.ErrorIs(t, &ConnectionError{Msg: "failed", Cause: context.Canceled}, context.Canceled)
+	assert.ErrorIs(t, &ClosingError{Msg: "closed", Cause: context.Canceled}, context.Canceled)
+	assert.Nil(t, NewTimeoutError("timed out").Unwrap())
+}
+
+func TestRetryError(t *testing.T) {
+	err := &RetryError{Attempts: 3, Err: NewTimeoutError("Request timed out")}
+	assert.Equal(t, "Request timed out (after 3 attempts)", err.Error())
+
+	var timeoutErr *TimeoutError
+	assert.True(t, errors.As(err, &timeoutErr))
+}
@@ -0,0 +1,120 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package errors
+
+import (
+	"regexp"
+	"strings"
+)
+
+func newServerErrorSentinel(code string) *RequestError {
+	return &RequestError{Msg: code, code: code}
+}
+
+// Sentinel values for the error codes sent by the server, to be used with [errors.Is].
+var (
+	// ErrWrongType is returned when an operation is performed against a key holding the wrong kind of value.
+	ErrWrongType = newServerErrorSentinel("WRONGTYPE")
+	// ErrNoScript is returned when a script or function with the given SHA1 digest doesn't exist.
+	ErrNoScript = newServerErrorSentinel("NOSCRIPT")
+	// ErrBusyGroup is returned when creating a stream consumer group which already exists.
+	ErrBusyGroup = newServerErrorSentinel("BUSYGROUP")
+	// ErrNoGroup is returned when the stream or the consumer group doesn't exist.
+	ErrNoGroup = newServerErrorSentinel("NOGROUP")
+	// ErrOutOfMemory is returned when a command is rejected because the server reached its memory limit.
+	ErrOutOfMemory = newServerErrorSentinel("OOM")
+	// ErrReadOnly is returned when a write command is sent to a read only replica.
+	ErrReadOnly = newServerErrorSentinel("READONLY")
+	// ErrCrossSlot is returned when the keys of a command don't hash to the same slot.
+	ErrCrossSlot = newServerErrorSentinel("CROSSSLOT")
+	// ErrBusy is returned while the server is running a script or function which hasn't completed yet.
+	ErrBusy = newServerErrorSentinel("BUSY")
+	// ErrNotBusy is returned when trying to kill a script or function while none is running.
+	ErrNotBusy = newServerErrorSentinel("NOTBUSY")
+	// ErrNoPerm is returned when the user doesn't have the permissions required by the command or its keys.
+	ErrNoPerm = newServerErrorSentinel("NOPERM")
+	// ErrNoAuth is returned when the connection isn't authenticated.
+	ErrNoAuth = newServerErrorSentinel("NOAUTH")
+	// ErrWrongPass is returned when authentication fails due to an invalid username or password.
+	ErrWrongPass = newServerErrorSentinel("WRONGPASS")
+	// ErrLoading is returned while the server is loading the dataset into memory.
+	ErrLoading = newServerErrorSentinel("LOADING")
+	// ErrTryAgain is returned when a multi-key command can't be served during resharding.
+	ErrTryAgain = newServerErrorSentinel("TRYAGAIN")
+	// ErrClusterDown is returned when the cluster is down.
+	ErrClusterDown = newServerErrorSentinel("CLUSTERDOWN")
+	// ErrMasterDown is returned when a replica lost the link with its primary and can't serve stale data.
+	ErrMasterDown = newServerErrorSentinel("MASTERDOWN")
+	// ErrMoved is returned when a key is served by another node and the client couldn't follow the redirection.
+	ErrMoved = newServerErrorSentinel("MOVED")
+	// ErrAsk is returned when a key is being migrated and the client couldn't follow the redirection.
+	ErrAsk = newServerErrorSentinel("ASK")
+)
+
+// knownErrorKinds maps the error kinds the core reports for well known server errors to the server error codes.
+var knownErrorKinds = map[string]string{
+	"ResponseError":    "ERR",
+	"ExecAbortError":   "EXECABORT",
+	"BusyLoadingError": "LOADING",
+	"NoScriptError":    "NOSCRIPT",
+	"Moved":            "MOVED",
+	"Ask":              "ASK",
+	"TryAgain":         "TRYAGAIN",
+	"ClusterDown":      "CLUSTERDOWN",
+	"CrossSlot":        "CROSSSLOT",
+	"MasterDown":       "MASTERDOWN",
+	"ReadOnly":         "READONLY",
+	"NotBusy":          "NOTBUSY",
+}
+
+// knownErrorPrefix starts the messages of well known server errors, for example
+// "An error was signalled by the server: - ReadOnly: You can't write against a read only replica."
+const knownErrorPrefix = "An error was signalled by the server:"
+
+// extensionErrorRegex matches the messages of other server errors, for example
+// "WRONGTYPE: Operation against a key holding the wrong kind of value".
+var extensionErrorRegex = regexp.MustCompile(`^([A-Z][A-Z0-9_]*): `)
+
+// extensionErrorCodes are the codes of the other server errors which are classified. Messages starting with another
+// upper case word, such as the errors of modules or of scripts, are left unclassified.
+var extensionErrorCodes = map[string]bool{
+	"WRONGTYPE":   true,
+	"NOSCRIPT":    true,
+	"BUSYGROUP":   true,
+	"NOGROUP":     true,
+	"OOM":         true,
+	"READONLY":    true,
+	"CROSSSLOT":   true,
+	"BUSY":        true,
+	"NOTBUSY":     true,
+	"NOPERM":      true,
+	"NOAUTH":      true,
+	"WRONGPASS":   true,
+	"LOADING":     true,
+	"TRYAGAIN":    true,
+	"CLUSTERDOWN": true,
+	"MASTERDOWN":  true,
+	"MOVED":       true,
+	"ASK":         true,
+	"EXECABORT":   true,
+	"NOREPLICAS":  true,
+	"MISCONF":     true,
+	"UNBLOCKED":   true,
+}
+
+// parseServerErrorCode extracts the server error code from an error message reported by the core. It returns an empty
+// code for messages without a known code and for the generic ERR code.
+func parseServerErrorCode(msg string) string {
+	var code string
+	if rest, found := strings.CutPrefix(msg, knownErrorPrefix); found {
+		rest = strings.TrimLeft(rest, " -")
+		kind, _, _ := strings.Cut(rest, ":")
+		code = knownErrorKinds[strings.TrimSpace(kind)]
+	} else if match := extensionErrorRegex.FindStringSubmatch(msg); match != nil && extensionErrorCodes[match[1]] {
+		code = match[1]
+	}
+	if code == "ERR" {
+		return ""
+	}
+	return code
+}
@@ -0,0 +1,47 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"context"
+
+	"github.com/valkey-io/valkey-glide/go/api/internal/faulthook"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+func init() {
+	faulthook.Attach = func(client any, hook faulthook.Hook) bool {
+		target, ok := client.(interface{ setFaultHook(hook faulthook.Hook) })
+		if ok {
+			target.setFaultHook(hook)
+		}
+		return ok
+	}
+	faulthook.KeyHashSlot = keyHashSlot
+}
+
+// setFaultHook sets the hook evaluating the commands of the client before they are sent, which is set by package faults
+// only.
+func (client *baseClient) setFaultHook(hook faulthook.Hook) {
+	if hook == nil {
+		client.faultHook.Store(nil)
+		return
+	}
+	client.faultHook.Store(&hook)
+}
+
+// injectFault evaluates the command with the fault hook of the client, if any.
+func (client *baseClient) injectFault(ctx context.Context, requestType C.RequestType, args []string) error {
+	hook := client.faultHook.Load()
+	if hook == nil {
+		return nil
+	}
+	command, commandArgs := protobuf.RequestType(requestType).String(), args
+	if requestType == C.CustomCommand && len(args) > 0 {
+		command, commandArgs = args[0], args[1:]
+	}
+	return (*hook)(ctx, command, commandArgs)
+}
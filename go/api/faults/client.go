@@ -0,0 +1,82 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package faults
+
+import (
+	"fmt"
+
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/internal/faulthook"
+)
+
+// Client is a standalone client whose commands are evaluated against the rules of an [Injector] before they are sent.
+//
+// The injector is attached to the wrapped client itself, so that the faults are injected below its retries and circuit
+// breaker. The commands sent directly with the wrapped client go through the injector as well, until [Client.Detach] is
+// called.
+type Client struct {
+	api.GlideClientCommands
+	injector *Injector
+}
+
+// NewClient wraps client with injector. It fails if client isn't a client returned by [api.NewGlideClient].
+func NewClient(client api.GlideClientCommands, injector *Injector) (*Client, error) {
+	if err := attach(client, injector); err != nil {
+		return nil, err
+	}
+	return &Client{GlideClientCommands: client, injector: injector}, nil
+}
+
+// Injector returns the injector of the client.
+func (client *Client) Injector() *Injector { return client.injector }
+
+// Detach stops evaluating the commands of the wrapped client against the rules of the injector.
+func (client *Client) Detach() { faulthook.Attach(client.GlideClientCommands, nil) }
+
+// ClusterClient is a cluster client whose commands are evaluated against the rules of an [Injector] before they are sent.
+//
+// The injector is attached to the wrapped client itself, so that the faults are injected below its retries, circuit
+// breaker and hedged reads. The commands sent directly with the wrapped client go through the injector as well, until
+// [ClusterClient.Detach] is called.
+type ClusterClient struct {
+	api.GlideClusterClientCommands
+	injector *Injector
+}
+
+// NewClusterClient wraps client with injector. It fails if client isn't a client returned by
+// [api.NewGlideClusterClient].
+func NewClusterClient(client api.GlideClusterClientCommands, injector *Injector) (*ClusterClient, error) {
+	if err := attach(client, injector); err != nil {
+		return nil, err
+	}
+	return &ClusterClient{GlideClusterClientCommands: client, injector: injector}, nil
+}
+
+// Injector returns the injector of the client.
+func (client *ClusterClient) Injector() *Injector { return client.injector }
+
+// Detach stops evaluating the commands of the wrapped client against the rules of the injector.
+func (client *ClusterClient) Detach() { faulthook.Attach(client.GlideClusterClientCommands, nil) }
+
+// Wrap wraps a standalone or a cluster client with injector, returning a [*Client] or a [*ClusterClient]. It fails for
+// other clients.
+func Wrap(client api.BaseClient, injector *Injector) (api.BaseClient, error) {
+	switch client := client.(type) {
+	case *api.GlideClient:
+		return NewClient(client, injector)
+	case *api.GlideClusterClient:
+		return NewClusterClient(client, injector)
+	}
+	return nil, &errors.ConfigurationError{Msg: fmt.Sprintf("faults can't be injected into a %T", client)}
+}
+
+func attach(client api.BaseClient, injector *Injector) error {
+	if injector == nil {
+		return &errors.ConfigurationError{Msg: "the fault injector is nil"}
+	}
+	if !faulthook.Attach(client, injector.inject) {
+		return &errors.ConfigurationError{Msg: fmt.Sprintf("faults can't be injected into a %T", client)}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package faults injects faults into the commands of the clients of package api, to test how applications and the client
+// itself behave when commands time out, connections fail or the cluster redirects commands. It is intended for tests only.
+//
+// An [Injector] holds rules describing the faults. A client wrapped with [NewClient], [NewClusterClient] or [Wrap]
+// evaluates every command it sends against the rules, before sending it. The faults are injected below the retries, the
+// circuit breaker and the hedged reads of the client, which handle them like the failures they simulate.
+package faults
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/internal/faulthook"
+)
+
+// Rule describes a fault injected by an [Injector] into the commands sent by a client.
+//
+// A rule applies to a command when the command matches Commands and KeyPattern, the rule has not been applied Times times
+// yet, and a random draw succeeds according to Probability. When a rule applies, the command is delayed by Latency and then
+// fails with Err or with the cluster redirection set by Redirect, without being sent to the server. A rule without Err and
+// Redirect only delays the command.
+type Rule struct {
+	// Commands restricts the rule to the given commands. Commands are identified by their request type name, as in
+	// "HGet" or "XGroupCreate", or by the command name for custom commands, as in "HGET". Names are matched
+	// case-insensitively. An empty list matches all commands.
+	Commands []string
+	// KeyPattern restricts the rule to commands whose first argument, which is the key for single-key commands, matches
+	// the glob-style pattern. See [path.Match] for the pattern syntax. An empty pattern matches all commands.
+	KeyPattern string
+	// Probability is the chance, between 0 and 1, that a matching command is affected. Nil means every matching command
+	// is affected, and zero means none is.
+	Probability *float64
+	// Times is the number of times the rule is applied before it becomes inactive. Zero means the rule is never exhausted.
+	Times int
+	// Latency is added to every affected command before it is sent or before Err is returned.
+	Latency time.Duration
+	// Err is returned instead of sending the command to the server. Use [errors.NewTimeoutError],
+	// [errors.NewDisconnectError] or [errors.ConnectionError] to simulate transport failures.
+	Err error
+	// Redirect fails the command with a MOVED or ASK redirection to RedirectTo, which the client reports like the
+	// redirections the server sends and it couldn't follow. It can't be combined with Err.
+	Redirect Redirect
+	// RedirectTo is the address of the node the command is redirected to, as in "10.0.0.1:6379".
+	RedirectTo string
+}
+
+// Redirect is a cluster redirection injected by a [Rule].
+type Redirect int
+
+const (
+	// NoRedirect doesn't redirect the command.
+	NoRedirect Redirect = iota
+	// RedirectMoved redirects the command with a MOVED error, as when its slot moved to another node.
+	RedirectMoved
+	// RedirectAsk redirects the command with an ASK error, as when its slot is being migrated to another node.
+	RedirectAsk
+)
+
+// fault returns the error of the rule for a command with the given arguments, or nil if the rule only delays it.
+func (rule *Rule) fault(args []string) error {
+	if rule.Redirect == NoRedirect {
+		return rule.Err
+	}
+	kind := "Moved"
+	if rule.Redirect == RedirectAsk {
+		kind = "Ask"
+	}
+	slot := 0
+	if len(args) > 0 {
+		slot = faulthook.KeyHashSlot(args[0])
+	}
+	// The error is built from the message the core reports for a redirection, with the error type of the request errors,
+	// so that it is classified like one.
+	msg := fmt.Sprintf("An error was signalled by the server: - %s: %d %s", kind, slot, rule.RedirectTo)
+	return errors.GoError(0, msg)
+}
+
+type faultRuleState struct {
+	id      uint64
+	rule    Rule
+	applied int
+}
+
+func (state *faultRuleState) matches(command string, args []string) bool {
+	if state.rule.Times > 0 && state.applied >= state.rule.Times {
+		return false
+	}
+	if len(state.rule.Commands) > 0 {
+		found := false
+		for _, name := range state.rule.Commands {
+			if strings.EqualFold(name, command) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if state.rule.KeyPattern != "" {
+		if len(args) == 0 {
+			return false
+		}
+		// The pattern was validated in AddRule.
+		if matched, _ := path.Match(state.rule.KeyPattern, args[0]); !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// Injector holds a set of [Rule]s that are evaluated for every command sent by the clients wrapped with it. Rules can be
+// added and removed at any time, including while commands are in flight.
+type Injector struct {
+	mu     sync.Mutex
+	rules  []*faultRuleState
+	nextId uint64
+	random *rand.Rand
+}
+
+// NewInjector returns an [Injector] without rules.
+func NewInjector() *Injector {
+	return &Injector{random: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+// SetSeed seeds the random source used for [Rule.Probability], making the injected faults reproducible.
+func (injector *Injector) SetSeed(seed int64) {
+	injector.mu.Lock()
+	defer injector.mu.Unlock()
+	injector.random = rand.New(rand.NewSource(seed))
+}
+
+// AddRule registers rule and returns an identifier that can be passed to [Injector.RemoveRule] and
+// [Injector.Applied]. Rules are evaluated in the order they were added.
+func (injector *Injector) AddRule(rule Rule) (uint64, error) {
+	if rule.Probability != nil && (*rule.Probability < 0 || *rule.Probability > 1) {
+		return 0, &errors.ConfigurationError{Msg: fmt.Sprintf("invalid fault probability %v", *rule.Probability)}
+	}
+	if rule.Redirect != NoRedirect {
+		if rule.Redirect != RedirectMoved && rule.Redirect != RedirectAsk {
+			return 0, &errors.ConfigurationError{Msg: fmt.Sprintf("invalid fault redirect %d", rule.Redirect)}
+		}
+		if rule.Err != nil {
+			return 0, &errors.ConfigurationError{Msg: "a fault rule can't both redirect and return an error"}
+		}
+		if _, _, err := net.SplitHostPort(rule.RedirectTo); err != nil {
+			return 0, &errors.ConfigurationError{Msg: fmt.Sprintf("invalid fault redirect address %q", rule.RedirectTo)}
+		}
+	}
+	if rule.Times < 0 {
+		return 0, &errors.ConfigurationError{Msg: fmt.Sprintf("invalid fault count %d", rule.Times)}
+	}
+	if _, err := path.Match(rule.KeyPattern, ""); err != nil {
+		return 0, &errors.ConfigurationError{Msg: fmt.Sprintf("invalid key pattern %q: %s", rule.KeyPattern, err.Error())}
+	}
+	rule.Commands = append([]string(nil), rule.Commands...)
+
+	injector.mu.Lock()
+	defer injector.mu.Unlock()
+	injector.nextId++
+	injector.rules = append(injector.rules, &faultRuleState{id: injector.nextId, rule: rule})
+	return injector.nextId, nil
+}
+
+// RemoveRule removes the rule with the given identifier. It returns false if there is no such rule.
+func (injector *Injector) RemoveRule(id uint64) bool {
+	injector.mu.Lock()
+	defer injector.mu.Unlock()
+	for i, state := range injector.rules {
+		if state.id == id {
+			injector.rules = append(injector.rules[:i], injector.rules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// ClearRules removes all rules.
+func (injector *Injector) ClearRules() {
+	injector.mu.Lock()
+	defer injector.mu.Unlock()
+	injector.rules = nil
+}
+
+// Applied returns how many times the rule with the given identifier has been applied, or 0 if there is no such rule.
+func (injector *Injector) Applied(id uint64) int {
+	injector.mu.Lock()
+	defer injector.mu.Unlock()
+	for _, state := range injector.rules {
+		if state.id == id {
+			return state.applied
+		}
+	}
+	return 0
+}
+
+// inject evaluates the rules for the given command. The latency of all applied rules is added up, and the error of the
+// first applied rule which has one is returned.
+func (injector *Injector) inject(ctx context.Context, command string, args []string) error {
+	var latency time.Duration
+	var err error
+
+	injector.mu.Lock()
+	for _, state := range injector.rules {
+		if !state.matches(command, args) {
+			continue
+		}
+		if state.rule.Probability != nil && injector.random.Float64() >= *state.rule.Probability {
+			continue
+		}
+		fault := state.rule.fault(args)
+		if fault != nil && err != nil {
+			// Only one error can be returned, keep this rule for a later command.
+			continue
+		}
+		state.applied++
+		latency += state.rule.Latency
+		if fault != nil {
+			err = fault
+		}
+	}
+	injector.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return err
+}
@@ -0,0 +1,115 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package faults
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func TestInjector_FailNextN(t *testing.T) {
+	injector := NewInjector()
+	timeout := errors.NewTimeoutError("injected timeout")
+	id, err := injector.AddRule(Rule{Commands: []string{"HGet"}, Times: 2, Err: timeout})
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	assert.NoError(t, injector.inject(ctx, "Get", []string{"key"}))
+	assert.Equal(t, timeout, injector.inject(ctx, "HGet", []string{"key", "field"}))
+	assert.Equal(t, timeout, injector.inject(ctx, "hget", []string{"key", "field"}))
+	assert.NoError(t, injector.inject(ctx, "HGet", []string{"key", "field"}))
+	assert.Equal(t, 2, injector.Applied(id))
+}
+
+func TestInjector_KeyPattern(t *testing.T) {
+	injector := NewInjector()
+	connErr := &errors.ConnectionError{Msg: "injected disconnect"}
+	_, err := injector.AddRule(Rule{KeyPattern: "user:*", Err: connErr})
+	assert.NoError(t, err)
+
+	ctx := context.Background()
+	assert.Equal(t, connErr, injector.inject(ctx, "Set", []string{"user:1", "value"}))
+	assert.NoError(t, injector.inject(ctx, "Set", []string{"order:1", "value"}))
+	assert.NoError(t, injector.inject(ctx, "Ping", nil))
+}
+
+func TestInjector_Probability(t *testing.T) {
+	injector := NewInjector()
+	injector.SetSeed(1)
+	probability := 0.05
+	id, err := injector.AddRule(Rule{Probability: &probability, Err: errors.NewTimeoutError("injected")})
+	assert.NoError(t, err)
+	never := 0.0
+	neverId, err := injector.AddRule(Rule{Probability: &never, Latency: time.Hour})
+	assert.NoError(t, err)
+
+	for i := 0; i < 10000; i++ {
+		_ = injector.inject(context.Background(), "Get", []string{"key"})
+	}
+	assert.InDelta(t, 500, injector.Applied(id), 100)
+	assert.Equal(t, 0, injector.Applied(neverId))
+}
+
+func TestInjector_Redirect(t *testing.T) {
+	injector := NewInjector()
+	node := "10.0.0.2:6379"
+	_, err := injector.AddRule(Rule{Commands: []string{"Get"}, Times: 1, Redirect: RedirectMoved, RedirectTo: node})
+	assert.NoError(t, err)
+	_, err = injector.AddRule(Rule{Commands: []string{"Set"}, Times: 1, Redirect: RedirectAsk, RedirectTo: node})
+	assert.NoError(t, err)
+
+	// "foo" hashes to slot 12182.
+	err = injector.inject(context.Background(), "Get", []string{"foo"})
+	assert.Equal(t, errors.GoError(0, "An error was signalled by the server: - Moved: 12182 10.0.0.2:6379"), err)
+	assert.ErrorIs(t, err, errors.ErrMoved)
+	err = injector.inject(context.Background(), "Set", []string{"foo", "bar"})
+	assert.ErrorIs(t, err, errors.ErrAsk)
+	assert.NoError(t, injector.inject(context.Background(), "Get", []string{"foo"}))
+}
+
+func TestInjector_LatencyHonorsContext(t *testing.T) {
+	injector := NewInjector()
+	_, err := injector.AddRule(Rule{Latency: time.Hour})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, injector.inject(ctx, "Get", []string{"key"}), context.DeadlineExceeded)
+}
+
+func TestInjector_RemoveAndValidateRules(t *testing.T) {
+	injector := NewInjector()
+	id, err := injector.AddRule(Rule{Err: errors.NewDisconnectError("injected")})
+	assert.NoError(t, err)
+	assert.Error(t, injector.inject(context.Background(), "Get", []string{"key"}))
+
+	assert.True(t, injector.RemoveRule(id))
+	assert.False(t, injector.RemoveRule(id))
+	assert.NoError(t, injector.inject(context.Background(), "Get", []string{"key"}))
+
+	probability := 2.0
+	_, err = injector.AddRule(Rule{Probability: &probability})
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+	_, err = injector.AddRule(Rule{Redirect: RedirectMoved})
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+	_, err = injector.AddRule(Rule{Redirect: RedirectAsk, RedirectTo: "10.0.0.2:6379", Err: errors.NewTimeoutError("t")})
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+	_, err = injector.AddRule(Rule{KeyPattern: "["})
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+}
+
+func TestWrap_RejectsOtherClients(t *testing.T) {
+	_, err := Wrap(nil, NewInjector())
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+	_, err = NewClient(nil, NewInjector())
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+	_, err = NewClusterClient(nil, NewInjector())
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+	_, err = NewClient(&api.GlideClient{}, nil)
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+}
@@ -0,0 +1,327 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+// #include "../lib.h"
+import "C"
+
+import (
+	"context"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+	"github.com/valkey-io/valkey-glide/go/utils"
+)
+
+// FireAndForgetClient sends commands without receiving their response, for high volumes of commands whose replies aren't
+// needed, such as metric increments. No waiter is registered for the commands: their responses are discarded, and their
+// errors are passed to the handler set with [GlideClientConfiguration.WithFireAndForgetErrorHandler] or
+// [GlideClusterClientConfiguration.WithFireAndForgetErrorHandler], if any. Use [BaseClient.FireAndForget] to get the
+// FireAndForgetClient of a client, and [FireAndForgetClient.Flush] to wait for the commands sent.
+//
+// The commands return an error when they can't be sent, for example when the client is closed. The deadline of the context
+// passed to a command bounds the time the command may take, but cancelling the context doesn't cancel a command already
+// sent. The commands are neither retried, nor hedged, nor go through the circuit breaker of the client.
+//
+// For example:
+//
+//	for _, event := range events {
+//	    if err := client.FireAndForget().HIncrBy(ctx, "metrics", event.Name, 1); err != nil {
+//	        return err
+//	    }
+//	}
+//	err := client.FireAndForget().Flush(ctx)
+type FireAndForgetClient struct {
+	client *baseClient
+}
+
+// FireAndForget returns a [FireAndForgetClient], sending commands through the client without receiving their response.
+func (client *baseClient) FireAndForget() *FireAndForgetClient {
+	return &FireAndForgetClient{client}
+}
+
+// Flush waits until all the commands sent by the FireAndForgetClient of the client before Flush was called have been
+// written to the server and completed, or until ctx is done.
+func (fireAndForget *FireAndForgetClient) Flush(ctx context.Context) error {
+	client := fireAndForget.client
+	if client.shards != nil {
+		for _, shard := range client.shards.clients() {
+			if err := shard.FireAndForget().Flush(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := client.fireAndForget.flush(ctx); err != nil {
+		return err
+	}
+	if client.connections != nil {
+		for _, connection := range client.connections.extra {
+			if err := connection.fireAndForget.flush(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Set is the fire-and-forget flavor of [StringCommands.Set].
+func (fireAndForget *FireAndForgetClient) Set(ctx context.Context, key string, value string) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.Set, []string{key, value})
+}
+
+// Incr is the fire-and-forget flavor of [StringCommands.Incr].
+func (fireAndForget *FireAndForgetClient) Incr(ctx context.Context, key string) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.Incr, []string{key})
+}
+
+// IncrBy is the fire-and-forget flavor of [StringCommands.IncrBy].
+func (fireAndForget *FireAndForgetClient) IncrBy(ctx context.Context, key string, amount int64) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.IncrBy, []string{key, utils.IntToString(amount)})
+}
+
+// IncrByFloat is the fire-and-forget flavor of [StringCommands.IncrByFloat].
+func (fireAndForget *FireAndForgetClient) IncrByFloat(ctx context.Context, key string, amount float64) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.IncrByFloat, []string{key, utils.FloatToString(amount)})
+}
+
+// DecrBy is the fire-and-forget flavor of [StringCommands.DecrBy].
+func (fireAndForget *FireAndForgetClient) DecrBy(ctx context.Context, key string, amount int64) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.DecrBy, []string{key, utils.IntToString(amount)})
+}
+
+// HSet is the fire-and-forget flavor of [HashCommands.HSet].
+func (fireAndForget *FireAndForgetClient) HSet(ctx context.Context, key string, values map[string]string) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.HSet, utils.ConvertMapToKeyValueStringArray(key, values))
+}
+
+// HIncrBy is the fire-and-forget flavor of [HashCommands.HIncrBy].
+func (fireAndForget *FireAndForgetClient) HIncrBy(ctx context.Context, key string, field string, increment int64) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.HIncrBy, []string{key, field, utils.IntToString(increment)})
+}
+
+// PfAdd is the fire-and-forget flavor of [HyperLogLogCommands.PfAdd].
+func (fireAndForget *FireAndForgetClient) PfAdd(ctx context.Context, key string, elements []string) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.PfAdd, append([]string{key}, elements...))
+}
+
+// SAdd is the fire-and-forget flavor of [SetCommands.SAdd].
+func (fireAndForget *FireAndForgetClient) SAdd(ctx context.Context, key string, members []string) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.SAdd, append([]string{key}, members...))
+}
+
+// ZIncrBy is the fire-and-forget flavor of [SortedSetCommands.ZIncrBy].
+func (fireAndForget *FireAndForgetClient) ZIncrBy(ctx context.Context, key string, increment float64, member string) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.ZIncrBy, []string{key, utils.FloatToString(increment), member})
+}
+
+// Expire is the fire-and-forget flavor of [GenericBaseCommands.Expire].
+func (fireAndForget *FireAndForgetClient) Expire(ctx context.Context, key string, seconds int64) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.Expire, []string{key, utils.IntToString(seconds)})
+}
+
+// Del is the fire-and-forget flavor of [GenericBaseCommands.Del].
+func (fireAndForget *FireAndForgetClient) Del(ctx context.Context, keys []string) error {
+	return fireAndForget.client.sendFireAndForget(ctx, C.Del, keys)
+}
+
+// sendFireAndForget sends the command without waiting for its response. Like sendCommand, the command is routed following
+// the ReadFrom strategy of ctx, and spread between the connections of the client.
+func (client *baseClient) sendFireAndForget(ctx context.Context, requestType C.RequestType, args []string) error {
+	if client.shards != nil {
+		shard, err := client.shards.shardFor(protobuf.RequestType(requestType), args)
+		if err != nil {
+			return err
+		}
+		return shard.sendFireAndForget(ctx, requestType, args)
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	route, err := client.readFromRoute(ctx, protobuf.RequestType(requestType), args, nil)
+	if err != nil {
+		return err
+	}
+	if err := client.injectFault(ctx, requestType, args); err != nil {
+		return err
+	}
+
+	connection := client
+	if client.connections != nil {
+		// The commands aren't counted as in flight, since their completion isn't tracked per connection.
+		index := client.connections.acquire()
+		client.connections.release(index)
+		if index > 0 {
+			connection = client.connections.extra[index-1]
+		}
+	}
+	return connection.startFireAndForget(ctx, requestType, args, route, client.fireAndForgetErrorHandler)
+}
+
+// startFireAndForget sends the command to the core client of the client as part of the current fire-and-forget batch.
+func (client *baseClient) startFireAndForget(
+	ctx context.Context,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+	onError func(error),
+) error {
+	batch, err := client.fireAndForget.acquire(client, onError)
+	if err != nil {
+		return err
+	}
+	if err := client.startCommand(ctx, &batch.request, requestType, args, route); err != nil {
+		client.fireAndForget.release(batch)
+		return err
+	}
+	return nil
+}
+
+// fireAndForgetState tracks the fire-and-forget commands sent by a client. The commands are grouped in batches, which end
+// when the client is flushed. Every command of a batch is sent with the shared pending request of the batch, registered
+// until all the commands of the batch completed, so that commands don't allocate nor register a pending request of their
+// own.
+type fireAndForgetState struct {
+	mu      sync.Mutex
+	current *fireAndForgetBatch
+	// batches holds the batches whose commands haven't all completed.
+	batches map[*fireAndForgetBatch]struct{}
+	closed  bool
+}
+
+type fireAndForgetBatch struct {
+	request pendingRequest
+	// inflight counts the commands of the batch awaiting their completion.
+	inflight int
+	// done is closed once the batch ended and all its commands completed.
+	done     chan struct{}
+	finished bool
+}
+
+// acquire returns the current batch, counting a command sent with it as in flight until release is called.
+func (state *fireAndForgetState) acquire(client *baseClient, onError func(error)) (*fireAndForgetBatch, error) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.closed {
+		return nil, &errors.ClosingError{Msg: "FireAndForget failed. The client is closed."}
+	}
+	if state.current == nil {
+		batch := &fireAndForgetBatch{
+			request: pendingRequest{client: client, shared: true},
+			done:    make(chan struct{}),
+		}
+		batch.request.complete = func(result payload) {
+			if result.value != nil {
+				C.free_command_response(result.value)
+			}
+			if result.error != nil && onError != nil {
+				// The handler doesn't run on the thread of the core invoking the callback.
+				go onError(result.error)
+			}
+			state.release(batch)
+		}
+		pendingRequests.register(&batch.request)
+		if state.batches == nil {
+			state.batches = make(map[*fireAndForgetBatch]struct{})
+		}
+		state.batches[batch] = struct{}{}
+		state.current = batch
+	}
+	state.current.inflight++
+	return state.current, nil
+}
+
+// release marks a command of batch as completed.
+func (state *fireAndForgetState) release(batch *fireAndForgetBatch) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	batch.inflight--
+	if batch.inflight == 0 && batch != state.current {
+		state.finish(batch)
+	}
+}
+
+// finish unregisters an ended batch whose commands all completed. Must be called with the lock held.
+func (state *fireAndForgetState) finish(batch *fireAndForgetBatch) {
+	if batch.finished {
+		return
+	}
+	batch.finished = true
+	pendingRequests.unregister(batch.request.id)
+	delete(state.batches, batch)
+	close(batch.done)
+}
+
+// flush ends the current batch, and waits until the commands of all the batches completed or ctx is done.
+func (state *fireAndForgetState) flush(ctx context.Context) error {
+	state.mu.Lock()
+	if state.closed {
+		state.mu.Unlock()
+		return &errors.ClosingError{Msg: "Flush failed. The client is closed."}
+	}
+	if batch := state.current; batch != nil {
+		state.current = nil
+		if batch.inflight == 0 {
+			state.finish(batch)
+		}
+	}
+	done := make([]chan struct{}, 0, len(state.batches))
+	for batch := range state.batches {
+		done = append(done, batch.done)
+	}
+	state.mu.Unlock()
+
+	for _, batchDone := range done {
+		select {
+		case <-batchDone:
+		case <-ctx.Done():
+			return contextError(ctx)
+		}
+	}
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if state.closed {
+		return &errors.ClosingError{Msg: "Flush failed. The client is closed."}
+	}
+	return nil
+}
+
+// detach ends the current batch, and returns the batches whose commands haven't all completed. Used when the client
+// switches to a new core client, which completes the commands of new batches only.
+func (state *fireAndForgetState) detach() []*fireAndForgetBatch {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	if batch := state.current; batch != nil {
+		state.current = nil
+		if batch.inflight == 0 {
+			state.finish(batch)
+		}
+	}
+	batches := make([]*fireAndForgetBatch, 0, len(state.batches))
+	for batch := range state.batches {
+		batches = append(batches, batch)
+	}
+	return batches
+}
+
+// abandon unregisters batches returned by detach, once the core client their commands were sent with is closed.
+func (state *fireAndForgetState) abandon(batches []*fireAndForgetBatch) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	for _, batch := range batches {
+		state.finish(batch)
+	}
+}
+
+// close unregisters all the batches, once the core client is closed and won't complete their commands anymore.
+func (state *fireAndForgetState) close() {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.closed = true
+	state.current = nil
+	for batch := range state.batches {
+		state.finish(batch)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func TestFireAndForgetState_Flush(t *testing.T) {
+	state := &fireAndForgetState{}
+	defer state.close()
+	flush := func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		return state.flush(ctx)
+	}
+	assert.NoError(t, flush())
+
+	first, err := state.acquire(nil, nil)
+	assert.NoError(t, err)
+	same, err := state.acquire(nil, nil)
+	assert.NoError(t, err)
+	assert.Same(t, first, same)
+	state.release(same)
+
+	// Flush ends the batch, and waits for its commands.
+	assert.ErrorIs(t, flush(), context.DeadlineExceeded)
+	second, err := state.acquire(nil, nil)
+	assert.NoError(t, err)
+	assert.NotSame(t, first, second)
+	state.release(first)
+	<-first.done
+
+	// The commands sent after the end of a batch are waited for by the next flush only.
+	assert.ErrorIs(t, flush(), context.DeadlineExceeded)
+	state.release(second)
+	assert.NoError(t, flush())
+	assert.Empty(t, state.batches)
+}
+
+func TestFireAndForgetState_Errors(t *testing.T) {
+	state := &fireAndForgetState{}
+	errs := make(chan error, 1)
+	batch, err := state.acquire(nil, func(err error) { errs <- err })
+	assert.NoError(t, err)
+
+	failure := &errors.RequestError{Msg: "WRONGTYPE"}
+	batch.request.deliver(payload{error: failure})
+	assert.Equal(t, failure, <-errs)
+	assert.NoError(t, state.flush(context.Background()))
+
+	_, err = state.acquire(nil, nil)
+	assert.NoError(t, err)
+	state.close()
+	_, err = state.acquire(nil, nil)
+	assert.IsType(t, &errors.ClosingError{}, err)
+	assert.IsType(t, &errors.ClosingError{}, state.flush(context.Background()))
+}
+
+func TestFireAndForgetState_Detach(t *testing.T) {
+	state := &fireAndForgetState{}
+	defer state.close()
+	detached, err := state.acquire(nil, nil)
+	assert.NoError(t, err)
+
+	// Detaching ends the current batch, which is finished once abandoned even though its command didn't complete.
+	batches := state.detach()
+	assert.Equal(t, []*fireAndForgetBatch{detached}, batches)
+	current, err := state.acquire(nil, nil)
+	assert.NoError(t, err)
+	assert.NotSame(t, detached, current)
+	state.abandon(batches)
+	<-detached.done
+
+	state.release(current)
+	assert.NoError(t, state.flush(context.Background()))
+	assert.Empty(t, state.batches)
+}
@@ -104,4 +104,8 @@ type GenericBaseCommands interface {
 	UpdateConnectionPassword(ctx context.Context, password string, immediateAuth bool) (string, error)
 
 	ResetConnectionPassword(ctx context.Context) (string, error)
+
+	MemoryUsage(ctx context.Context, key string) (Result[int64], error)
+
+	MemoryUsageWithOptions(ctx context.Context, key string, opts options.MemoryUsageOptions) (Result[int64], error)
 }
@@ -19,6 +19,8 @@ type GenericClusterCommands interface {
 
 	CustomCommandWithRoute(ctx context.Context, args []string, route config.Route) (ClusterValue[interface{}], error)
 
+	Move(ctx context.Context, key string, dbIndex int64) (bool, error)
+
 	Scan(ctx context.Context, cursor options.ClusterScanCursor) (options.ClusterScanCursor, []string, error)
 
 	ScanWithOptions(
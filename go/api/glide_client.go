@@ -24,6 +24,9 @@ type GlideClientCommands interface {
 	ConnectionManagementCommands
 	ScriptingAndFunctionStandaloneCommands
 	PubSubStandaloneCommands
+	AclStandaloneCommands
+
+	Dedicated(ctx context.Context) (*DedicatedClient, error)
 }
 
 // Client used for connection to standalone servers.
@@ -58,14 +61,80 @@ type GlideClient struct {
 //	  - **TLS**: If `UseTLS` is set to `true`, the client will establish a secure connection using TLS.
 //	  - **Reconnection Strategy**: The `BackoffStrategy` settings define how the client will attempt to reconnect
 //	      in case of disconnections.
+//	  - **Sentinel**: If a `SentinelConfig` is provided, the client discovers the nodes from the sentinels, and connects
+//	      to the promoted replica after a failover.
 func NewGlideClient(ctx context.Context, config *GlideClientConfiguration) (GlideClientCommands, error) {
+	if err := connectCredentials(ctx, config.credentials); err != nil {
+		return nil, err
+	}
+	if config.sentinel != nil {
+		var err error
+		if config, err = config.discoverSentinelNodes(ctx); err != nil {
+			return nil, err
+		}
+	}
 	client, err := createClient(config)
 	if err != nil {
 		return nil, err
 	}
+	client.retryPolicy = config.retryPolicy
+	client.readFrom = config.readFrom
+	client.clientAZ = config.clientAZ
+	client.fireAndForgetErrorHandler = config.fireAndForgetErrorHandler
+	client.database.Store(int64(config.databaseId))
+	// Separate connections talk to the primary only, without subscriptions.
+	connectionConfig := *config
+	connectionConfig.subscriptionConfig = nil
+	connectionConfig.readFrom = Primary
+	client.connectionConfig = &connectionConfig
+	// The commands executed with a ReadFrom override are served by a client configured with it.
+	readFromConfig := *config
+	readFromConfig.subscriptionConfig = nil
+	client.readFromConfig = func(readFrom ReadFrom) clientConfiguration {
+		readFromConfig := readFromConfig
+		readFromConfig.readFrom = readFrom
+		return client.followDatabase(&readFromConfig)
+	}
+	poolSize := config.dedicatedPoolSize
+	if poolSize == 0 {
+		poolSize = defaultDedicatedPoolSize
+	}
+	client.dedicated = newDedicatedPool(poolSize, config.databaseId, client.closed, func() (*baseClient, error) {
+		connection, err := createClient(&connectionConfig)
+		if err != nil {
+			return nil, err
+		}
+		// Cancelled blocking commands are unblocked, so that the connection can be reused.
+		connection.connectionConfig = &connectionConfig
+		connection.fireAndForgetErrorHandler = config.fireAndForgetErrorHandler
+		return connection, nil
+	})
+	if config.connectionsPerNode > 1 {
+		// Subscriptions are only established on the connection of the client.
+		extraConfig := *config
+		extraConfig.subscriptionConfig = nil
+		client.connections, err = openConnections(
+			client.followDatabase(&extraConfig),
+			config.connectionsPerNode,
+			config.connectionBalancing,
+		)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+	if config.hedging != nil {
+		client.hedger = newHedger(*config.hedging)
+	}
 	if config.subscriptionConfig != nil {
 		client.setMessageHandler(NewMessageHandler(config.subscriptionConfig.callback, config.subscriptionConfig.context))
 	}
+	if config.credentials != nil && config.credentials.provider != nil {
+		go client.refreshCredentials(config.credentials)
+	}
+	if config.sentinel != nil {
+		go newSentinelWatcher(client, config).run()
+	}
 
 	return &GlideClient{client}, nil
 }
@@ -146,7 +215,8 @@ func (client *GlideClient) ConfigGet(ctx context.Context, args []string) (map[st
 	return handleStringToStringMapResponse(res)
 }
 
-// Select changes the currently selected database.
+// Select changes the currently selected database. The database is selected again when the client reconnects, including
+// after a failover.
 //
 // See [valkey.io] for details.
 //
@@ -161,12 +231,13 @@ func (client *GlideClient) ConfigGet(ctx context.Context, args []string) (map[st
 //
 // [valkey.io]: https://valkey.io/commands/select/
 func (client *GlideClient) Select(ctx context.Context, index int64) (string, error) {
-	result, err := client.executeCommand(ctx, C.Select, []string{utils.IntToString(index)})
-	if err != nil {
-		return DefaultStringResponse, err
-	}
+	return client.selectDatabase(ctx, index)
+}
 
-	return handleOkResponse(result)
+// CurrentDatabase returns the index of the database selected by the client, with [GlideClientConfiguration.WithDatabaseId]
+// or [GlideClient.Select]. A database selected with a custom command isn't tracked.
+func (client *GlideClient) CurrentDatabase() int64 {
+	return client.database.Load()
 }
 
 // Gets information and statistics about the server.
@@ -745,3 +816,784 @@ func (client *GlideClient) Publish(ctx context.Context, channel string, message
 
 	return handleIntResponse(result)
 }
+
+// Gets the rules of a user.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//
+// Return value:
+//
+//	The rules of the user, or a nil [Result] when the user doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/acl-getuser/
+func (client *GlideClient) AclGetUser(ctx context.Context, username string) (Result[AclUser], error) {
+	result, err := client.executeCommand(ctx, C.AclGetUser, []string{username})
+	if err != nil {
+		return CreateNilAclUserResult(), err
+	}
+	return handleAclUserResponse(result)
+}
+
+// Lists the users and their rules, in the format of the ACL file.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array with the description of every user, such as "user default on nopass ~* &* +@all".
+//
+// [valkey.io]: https://valkey.io/commands/acl-list/
+func (client *GlideClient) AclList(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclList, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Lists the names of the users.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array of user names.
+//
+// [valkey.io]: https://valkey.io/commands/acl-users/
+func (client *GlideClient) AclUsers(ctx context.Context) ([]string, error) {
+	result, err := client.executeCommand(ctx, C.AclUsers, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// Gets the name of the user the connection is authenticated as.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The name of the user.
+//
+// [valkey.io]: https://valkey.io/commands/acl-whoami/
+func (client *GlideClient) AclWhoAmI(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclWhoami, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Checks whether a user may call a command with the given arguments, without calling it.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//	command - The name of the command.
+//	args - The arguments of the command.
+//
+// Return value:
+//
+//	`OK` when the user may call the command, or the reason the command would be denied otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/acl-dryrun/
+func (client *GlideClient) AclDryRun(ctx context.Context, username string, command string, args []string) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclDryRun, append([]string{username, command}, args...))
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkOrStringResponse(result)
+}
+
+// Reloads the users from the ACL file configured on the server, replacing all the users. The users are left unchanged when
+// the file is invalid.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success. An error is returned when the server isn't configured with an ACL file, or when the file is invalid.
+//
+// [valkey.io]: https://valkey.io/commands/acl-load/
+func (client *GlideClient) AclLoad(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclLoad, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Gets the 10 most recent security events, such as denied commands and failed authentications.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array of [AclLogEntry], from the most recent.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *GlideClient) AclLog(ctx context.Context) ([]AclLogEntry, error) {
+	result, err := client.executeCommand(ctx, C.AclLog, []string{})
+	if err != nil {
+		return nil, err
+	}
+	return handleAclLogResponse(result)
+}
+
+// Gets the most recent security events, such as denied commands and failed authentications.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The maximum number of entries to return. See [options.AclLogOptions].
+//
+// Return value:
+//
+//	An array of [AclLogEntry], from the most recent.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *GlideClient) AclLogWithOptions(ctx context.Context, opts options.AclLogOptions) ([]AclLogEntry, error) {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	result, err := client.executeCommand(ctx, C.AclLog, args)
+	if err != nil {
+		return nil, err
+	}
+	return handleAclLogResponse(result)
+}
+
+// Clears the security events logged by the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *GlideClient) AclLogReset(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.AclLog, []string{options.ResetKeyword})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Gets information about the current connection.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The [ClientInfo] of the connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *GlideClient) ClientInfo(ctx context.Context) (ClientInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClientInfo, []string{})
+	if err != nil {
+		return ClientInfo{}, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return ClientInfo{}, err
+	}
+	return convertClientInfo(data)
+}
+
+// Lists the connections to the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array with the [ClientInfo] of every connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-list/
+func (client *GlideClient) ClientList(ctx context.Context) ([]ClientInfo, error) {
+	result, err := client.executeCommand(ctx, C.ClientList, []string{})
+	if err != nil {
+		return nil, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	return convertClientList(data)
+}
+
+// Closes the connections matching the filters.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The filters of the connections to close, at least one must be set. See [options.ClientKillOptions].
+//
+// Return value:
+//
+//	The number of connections that were closed.
+//
+// [valkey.io]: https://valkey.io/commands/client-kill/
+func (client *GlideClient) ClientKill(ctx context.Context, opts *options.ClientKillOptions) (int64, error) {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	result, err := client.executeCommand(ctx, C.ClientKill, args)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Suspends the commands of all the clients, except replicas, for the given time.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	timeoutMillis - The duration of the pause in milliseconds.
+//	mode - The commands to suspend: options.PauseWrite for the commands which may write, or options.PauseAll.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *GlideClient) ClientPause(
+	ctx context.Context,
+	timeoutMillis int64,
+	mode options.ClientPauseMode,
+) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientPause, clientPauseArgs(timeoutMillis, mode))
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Resumes the commands suspended by [GlideClient.ClientPause].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *GlideClient) ClientUnpause(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientUnpause, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Sets whether the current connection is spared when the server evicts connections to free memory.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - Whether the connection is spared.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-evict/
+func (client *GlideClient) ClientNoEvict(ctx context.Context, enabled bool) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientNoEvict, []string{onOffArg(enabled)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Sets whether the commands of the current connection leave the last access time of the keys unchanged.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - Whether the last access time of the keys is left unchanged.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-touch/
+func (client *GlideClient) ClientNoTouch(ctx context.Context, enabled bool) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientNoTouch, []string{onOffArg(enabled)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Unblocks a connection blocked by a blocking command, such as `BLPop`. The connections of the client itself can only be
+// unblocked by another client, since the command would wait for the blocking command to complete.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	clientId - The id of the connection to unblock, as returned by `ClientId`.
+//	withError - Whether the blocking command fails with an error, rather than returning as if it timed out.
+//
+// Return value:
+//
+//	`true` when the connection was blocked and is unblocked, `false` otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/client-unblock/
+func (client *GlideClient) ClientUnblock(ctx context.Context, clientId int64, withError bool) (bool, error) {
+	result, err := client.executeCommand(ctx, C.ClientUnblock, clientUnblockArgs(clientId, withError))
+	if err != nil {
+		return defaultBoolResponse, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return defaultBoolResponse, err
+	}
+	return convertBoolValue(data)
+}
+
+// Sets an attribute of the current connection, reported by [GlideClient.ClientInfo] and [GlideClient.ClientList].
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	attribute - The attribute to set: options.ClientLibName or options.ClientLibVersion.
+//	value - The value of the attribute.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-setinfo/
+func (client *GlideClient) ClientSetInfo(
+	ctx context.Context,
+	attribute options.ClientInfoAttribute,
+	value string,
+) (string, error) {
+	result, err := client.executeCommand(ctx, C.ClientSetInfo, []string{string(attribute), value})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Gets the id of the connection the invalidation messages of the client side caching of the current connection are
+// redirected to.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The id of the connection, 0 when the messages are sent to the current connection, or -1 when client side caching is
+//	disabled.
+//
+// [valkey.io]: https://valkey.io/commands/client-getredir/
+func (client *GlideClient) ClientGetRedir(ctx context.Context) (int64, error) {
+	result, err := client.executeCommand(ctx, C.ClientGetRedir, []string{})
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+func clientPauseArgs(timeoutMillis int64, mode options.ClientPauseMode) []string {
+	return []string{utils.IntToString(timeoutMillis), string(mode)}
+}
+
+func clientUnblockArgs(clientId int64, withError bool) []string {
+	if withError {
+		return []string{utils.IntToString(clientId), "ERROR"}
+	}
+	return []string{utils.IntToString(clientId), "TIMEOUT"}
+}
+
+func onOffArg(enabled bool) string {
+	if enabled {
+		return "ON"
+	}
+	return "OFF"
+}
+
+// Gets the 10 most recent commands which exceeded the execution time configured by `slowlog-log-slower-than`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array of [SlowLogEntry], from the most recent.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-get/
+func (client *GlideClient) SlowLogGet(ctx context.Context) ([]SlowLogEntry, error) {
+	return client.slowLogGet(ctx, []string{})
+}
+
+// Gets the most recent commands which exceeded the execution time configured by `slowlog-log-slower-than`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The maximum number of entries to return. See [options.SlowLogOptions].
+//
+// Return value:
+//
+//	An array of [SlowLogEntry], from the most recent.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-get/
+func (client *GlideClient) SlowLogGetWithOptions(ctx context.Context, opts options.SlowLogOptions) ([]SlowLogEntry, error) {
+	args, err := opts.ToArgs()
+	if err != nil {
+		return nil, err
+	}
+	return client.slowLogGet(ctx, args)
+}
+
+func (client *GlideClient) slowLogGet(ctx context.Context, args []string) ([]SlowLogEntry, error) {
+	result, err := client.executeCommand(ctx, C.SlowLogGet, args)
+	if err != nil {
+		return nil, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	return convertSlowLog(data)
+}
+
+// Gets the number of entries of the slow log.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The number of entries.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-len/
+func (client *GlideClient) SlowLogLen(ctx context.Context) (int64, error) {
+	result, err := client.executeCommand(ctx, C.SlowLogLen, []string{})
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Clears the slow log.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-reset/
+func (client *GlideClient) SlowLogReset(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.SlowLogReset, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Gets the latest latency spike of every event monitored by the server. Events are monitored when
+// `latency-monitor-threshold` is configured.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	An array of [LatencyEvent].
+//
+// [valkey.io]: https://valkey.io/commands/latency-latest/
+func (client *GlideClient) LatencyLatest(ctx context.Context) ([]LatencyEvent, error) {
+	result, err := client.executeCommand(ctx, C.LatencyLatest, []string{})
+	if err != nil {
+		return nil, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	return convertLatencyLatest(data)
+}
+
+// Gets the latency spikes of an event, up to the 160 most recent.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	event - The name of the event, as returned by [GlideClient.LatencyLatest].
+//
+// Return value:
+//
+//	An array of [LatencySample], from the oldest.
+//
+// [valkey.io]: https://valkey.io/commands/latency-history/
+func (client *GlideClient) LatencyHistory(ctx context.Context, event string) ([]LatencySample, error) {
+	result, err := client.executeCommand(ctx, C.LatencyHistory, []string{event})
+	if err != nil {
+		return nil, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	return convertLatencyHistory(data)
+}
+
+// Gets a human readable analysis of the latency spikes of the server, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The report.
+//
+// [valkey.io]: https://valkey.io/commands/latency-doctor/
+func (client *GlideClient) LatencyDoctor(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.LatencyDoctor, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Clears the latency spikes of events.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	events - The names of the events to clear, or an empty slice to clear all the events.
+//
+// Return value:
+//
+//	The number of events that were cleared.
+//
+// [valkey.io]: https://valkey.io/commands/latency-reset/
+func (client *GlideClient) LatencyReset(ctx context.Context, events []string) (int64, error) {
+	result, err := client.executeCommand(ctx, C.LatencyReset, events)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Gets the latency distribution of commands.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	commands - The names of the commands, or an empty slice for all the commands which were called.
+//
+// Return value:
+//
+//	A map of [CommandHistogram] keyed by command name. Subcommands are named as "command|subcommand".
+//
+// [valkey.io]: https://valkey.io/commands/latency-histogram/
+func (client *GlideClient) LatencyHistogram(ctx context.Context, commands []string) (map[string]CommandHistogram, error) {
+	result, err := client.executeCommand(ctx, C.LatencyHistogram, commands)
+	if err != nil {
+		return nil, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	return convertLatencyHistogram(data)
+}
+
+// Gets the memory usage of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The [MemoryStats] of the server.
+//
+// [valkey.io]: https://valkey.io/commands/memory-stats/
+func (client *GlideClient) MemoryStats(ctx context.Context) (MemoryStats, error) {
+	result, err := client.executeCommand(ctx, C.MemoryStats, []string{})
+	if err != nil {
+		return MemoryStats{}, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return MemoryStats{}, err
+	}
+	return convertMemoryStats(data)
+}
+
+// Gets a human readable analysis of the memory usage of the server, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The report.
+//
+// [valkey.io]: https://valkey.io/commands/memory-doctor/
+func (client *GlideClient) MemoryDoctor(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.MemoryDoctor, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Gets the internal statistics of the memory allocator of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The statistics, in the format of the memory allocator.
+//
+// [valkey.io]: https://valkey.io/commands/memory-malloc-stats/
+func (client *GlideClient) MemoryMallocStats(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.MemoryMallocStats, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleStringResponse(result)
+}
+
+// Asks the memory allocator of the server to release the memory it doesn't use.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/memory-purge/
+func (client *GlideClient) MemoryPurge(ctx context.Context) (string, error) {
+	result, err := client.executeCommand(ctx, C.MemoryPurge, []string{})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
@@ -7,6 +7,12 @@ import "C"
 
 import (
 	"context"
+	goErrors "errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/valkey-io/valkey-glide/go/api/config"
@@ -26,6 +32,12 @@ type GlideClusterClientCommands interface {
 	ConnectionManagementClusterCommands
 	ScriptingAndFunctionClusterCommands
 	PubSubClusterCommands
+	AclClusterCommands
+	ClusterManagementCommands
+
+	CircuitBreakerStats() map[string]NodeCircuitStats
+
+	NodeLatencies() map[string]time.Duration
 }
 
 // Client used for connection to cluster servers.
@@ -62,15 +74,152 @@ type GlideClusterClient struct {
 //	      using the specified username and password.
 //	  - **TLS**: If `UseTLS` is set to `true`, the client will establish a secure connection using TLS.
 func NewGlideClusterClient(ctx context.Context, config *GlideClusterClientConfiguration) (GlideClusterClientCommands, error) {
+	if err := connectCredentials(ctx, config.credentials); err != nil {
+		return nil, err
+	}
 	client, err := createClient(config)
 	if err != nil {
 		return nil, err
 	}
+	client.retryPolicy = config.retryPolicy
+	client.readFrom = config.readFrom
+	client.clientAZ = config.clientAZ
+	client.fireAndForgetErrorHandler = config.fireAndForgetErrorHandler
+	// Separate connections talk to the primaries only, without subscriptions.
+	connectionConfig := *config
+	connectionConfig.subscriptionConfig = nil
+	connectionConfig.readFrom = Primary
+	client.database.Store(int64(config.databaseId))
+	client.connectionConfig = client.followDatabase(&connectionConfig)
+	if config.connectionsPerNode > 1 {
+		// Subscriptions are only established on the connection of the client.
+		extraConfig := *config
+		extraConfig.subscriptionConfig = nil
+		client.connections, err = openConnections(
+			client.followDatabase(&extraConfig),
+			config.connectionsPerNode,
+			config.connectionBalancing,
+		)
+		if err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+	if config.hedging != nil {
+		client.hedger = newHedger(*config.hedging)
+	}
 	if config.subscriptionConfig != nil {
 		client.setMessageHandler(NewMessageHandler(config.subscriptionConfig.callback, config.subscriptionConfig.context))
 	}
 
-	return &GlideClusterClient{client}, nil
+	clusterClient := &GlideClusterClient{client}
+	// The slot map is fetched on first use, unless the client relies on it from the start.
+	client.clusterSlots = newClusterSlots(clusterClient.fetchSlotMap)
+	client.nodeZones = newNodeZones(clusterClient.fetchNodeZone)
+	if config.circuitBreaker != nil || config.readFrom == LowestLatency {
+		// A failure leaves the slot map empty, in which case it is fetched again on a later command.
+		client.clusterSlots.refresh(ctx)
+	}
+	if config.circuitBreaker != nil {
+		client.circuitBreaker = newCircuitBreaker(*config.circuitBreaker, client.clusterSlots)
+	}
+	if config.readFrom == LowestLatency {
+		client.latencies = newLatencyTracker()
+		go clusterClient.probeLatencies(client.closed)
+	}
+	if config.credentials != nil && config.credentials.provider != nil {
+		go client.refreshCredentials(config.credentials)
+	}
+	return clusterClient, nil
+}
+
+// fetchSlotMap fetches the primary node serving every slot, bypassing the circuit breaker.
+func (client *GlideClusterClient) fetchSlotMap(ctx context.Context) (*slotMap, error) {
+	result, err := client.sendCommandToCore(ctx, C.CustomCommand, []string{"CLUSTER", "SLOTS"}, config.RandomRoute)
+	if err != nil {
+		return nil, err
+	}
+	data, err := handleInterfaceResponse(result)
+	if err != nil {
+		return nil, err
+	}
+	return parseClusterSlots(data)
+}
+
+// fetchNodeZone fetches the availability zone of node, which is empty when the node has none configured.
+func (client *GlideClusterClient) fetchNodeZone(ctx context.Context, node string) (string, error) {
+	route, ok := nodeRoute(node)
+	if !ok {
+		return "", fmt.Errorf("invalid node address %q", node)
+	}
+	result, err := client.sendCommandToCore(ctx, C.ConfigGet, []string{"availability-zone"}, route)
+	if err != nil {
+		return "", err
+	}
+	parameters, err := handleStringToStringMapResponse(result)
+	if err != nil {
+		return "", err
+	}
+	return parameters["availability-zone"], nil
+}
+
+// probeLatencies measures the latency of every node of the cluster with a PING every latencyProbeInterval, until closed is
+// closed.
+func (client *GlideClusterClient) probeLatencies(closed <-chan struct{}) {
+	ticker := time.NewTicker(latencyProbeInterval)
+	defer ticker.Stop()
+	for {
+		if slots := client.clusterSlots.load(); slots != nil {
+			nodes := slots.nodes()
+			client.latencies.retain(nodes)
+			for _, node := range nodes {
+				client.probeLatency(node)
+			}
+		}
+		select {
+		case <-closed:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (client *GlideClusterClient) probeLatency(node string) {
+	route, ok := nodeRoute(node)
+	if !ok {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), latencyProbeInterval)
+	defer cancel()
+	start := time.Now()
+	result, err := client.sendCommandToCore(ctx, C.Ping, nil, route)
+	if err != nil {
+		return
+	}
+	latency := time.Since(start)
+	if _, err = handleStringResponse(result); err == nil {
+		client.latencies.record(node, latency)
+	}
+}
+
+// NodeLatencies returns the latency measured for every node of the cluster, keyed by node address. The latencies are
+// moving averages of the round trip time of a PING sent to every node each second, and are used to route read-only
+// commands when the client is configured with [LowestLatency]. Returns nil for clients configured with another [ReadFrom]
+// strategy.
+func (client *GlideClusterClient) NodeLatencies() map[string]time.Duration {
+	if client.latencies == nil {
+		return nil
+	}
+	return client.latencies.snapshot()
+}
+
+// CircuitBreakerStats returns the circuit breaker statistics of every node that served a command, keyed by node address.
+// Returns nil when the client was created without [GlideClusterClientConfiguration.WithCircuitBreaker].
+func (client *GlideClusterClient) CircuitBreakerStats() map[string]NodeCircuitStats {
+	if client.circuitBreaker == nil {
+		return nil
+	}
+	return client.circuitBreaker.stats()
 }
 
 // CustomCommand executes a single command, specified by args, without checking inputs. Every part of the command,
@@ -447,6 +596,80 @@ func (client *GlideClusterClient) FlushDBWithOptions(
 	return handleOkResponse(result)
 }
 
+// Select changes the database selected on every node. The database is selected again on the connections opened later,
+// such as reconnections and connections to new nodes. Multiple databases are supported in cluster mode from Valkey 9.0.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	index - The index of the database to select.
+//
+// Return value:
+//
+//	A simple `"OK"` response.
+//
+// [valkey.io]: https://valkey.io/commands/select/
+func (client *GlideClusterClient) Select(ctx context.Context, index int64) (string, error) {
+	return client.selectDatabase(ctx, index)
+}
+
+// CurrentDatabase returns the index of the database selected by the client, with
+// [GlideClusterClientConfiguration.WithDatabaseId] or [GlideClusterClient.Select]. A database selected with a custom command
+// isn't tracked.
+func (client *GlideClusterClient) CurrentDatabase() int64 {
+	return client.database.Load()
+}
+
+// SwapDB swaps the content of two databases.
+// The command will be routed to all primary nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	index1 - The index of the first database.
+//	index2 - The index of the second database.
+//
+// Return value:
+//
+//	`"OK"` response on success.
+//
+// [valkey.io]: https://valkey.io/commands/swapdb/
+func (client *GlideClusterClient) SwapDB(ctx context.Context, index1 int64, index2 int64) (string, error) {
+	result, err := client.executeCommand(ctx, C.SwapDb, []string{utils.IntToString(index1), utils.IntToString(index2)})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleOkResponse(result)
+}
+
+// Move key from the currently selected database to the database specified by dbIndex.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key to move.
+//	dbIndex - The index of the database to move key to.
+//
+// Return value:
+//
+//	`true` if key was moved, or `false` if key doesn't exist in the current database, or already exists in the database
+//	specified by dbIndex.
+//
+// [valkey.io]: https://valkey.io/commands/move/
+func (client *GlideClusterClient) Move(ctx context.Context, key string, dbIndex int64) (bool, error) {
+	result, err := client.executeCommand(ctx, C.Move, []string{key, utils.IntToString(dbIndex)})
+	if err != nil {
+		return defaultBoolResponse, err
+	}
+	return handleBoolResponse(result)
+}
+
 // Echo the provided message back.
 // The command will be routed to a random node.
 //
@@ -514,25 +737,6 @@ func (client *GlideClusterClient) clusterScan(
 		// Continue with execution
 	}
 
-	// make the channel buffered, so that we don't need to acquire the client.mu in the successCallback and failureCallback.
-	resultChannel := make(chan payload, 1)
-	resultChannelPtr := unsafe.Pointer(&resultChannel)
-
-	pinner := pinner{}
-	pinnedChannelPtr := uintptr(pinner.Pin(resultChannelPtr))
-	defer pinner.Unpin()
-
-	client.mu.Lock()
-	if client.coreClient == nil {
-		client.mu.Unlock()
-		return nil, &errors.ClosingError{Msg: "Cluster Scan failed. The client is closed."}
-	}
-	client.pending[resultChannelPtr] = struct{}{}
-
-	cStr := C.CString(cursor.GetCursor())
-	c_cursor := C.new_cluster_cursor(cStr)
-	defer C.free(unsafe.Pointer(cStr))
-
 	args, err := opts.ToArgs()
 	if err != nil {
 		return nil, err
@@ -546,9 +750,23 @@ func (client *GlideClusterClient) clusterScan(
 		argLengthsPtr = &argLengths[0]
 	}
 
+	request := newPendingRequest(client.baseClient)
+
+	client.mu.Lock()
+	if client.coreClient == nil {
+		client.mu.Unlock()
+		request.free()
+		return nil, &errors.ClosingError{Msg: "Cluster Scan failed. The client is closed."}
+	}
+	client.registerRequest(request)
+
+	cStr := C.CString(cursor.GetCursor())
+	c_cursor := C.new_cluster_cursor(cStr)
+	defer C.free(unsafe.Pointer(cStr))
+
 	C.request_cluster_scan(
 		client.coreClient,
-		C.uintptr_t(pinnedChannelPtr),
+		C.uintptr_t(request.id),
 		c_cursor,
 		C.size_t(len(args)),
 		cArgsPtr,
@@ -556,31 +774,7 @@ func (client *GlideClusterClient) clusterScan(
 	)
 	client.mu.Unlock()
 
-	// Wait for result or context cancellation
-	var payload payload
-	select {
-	case <-ctx.Done():
-		client.mu.Lock()
-		if client.pending != nil {
-			delete(client.pending, resultChannelPtr)
-		}
-		client.mu.Unlock()
-		return nil, ctx.Err()
-	case payload = <-resultChannel:
-		// Continue with normal processing
-	}
-
-	client.mu.Lock()
-	if client.pending != nil {
-		delete(client.pending, resultChannelPtr)
-	}
-	client.mu.Unlock()
-
-	if payload.error != nil {
-		return nil, payload.error
-	}
-
-	return payload.value, nil
+	return client.waitForResponse(ctx, request)
 }
 
 // Incrementally iterates over the keys in the cluster.
@@ -2281,3 +2475,2055 @@ func (client *GlideClusterClient) ScriptKillWithRoute(ctx context.Context, route
 	}
 	return handleOkResponse(result)
 }
+
+// routeOrDefault returns the route of a command, which is defaultRoute unless the command is given another route. Commands
+// defaulting to multiple nodes are sent with their default route explicitly, so that their response is keyed by node.
+func routeOrDefault(route options.RouteOption, defaultRoute config.Route) config.Route {
+	if route.Route == nil {
+		return defaultRoute
+	}
+	return route.Route
+}
+
+// Gets the rules of a user from all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//
+// Return value:
+//
+//	A [ClusterValue] with the rules of the user on every node, or a nil [Result] for the nodes where the user doesn't
+//	exist.
+//
+// [valkey.io]: https://valkey.io/commands/acl-getuser/
+func (client *GlideClusterClient) AclGetUser(ctx context.Context, username string) (ClusterValue[Result[AclUser]], error) {
+	return client.AclGetUserWithRoute(ctx, username, options.RouteOption{})
+}
+
+// Gets the rules of a user.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route, or to all nodes when it isn't set.
+//
+// Return value:
+//
+//	A [ClusterValue] with the rules of the user, or a nil [Result] when the user doesn't exist.
+//
+// [valkey.io]: https://valkey.io/commands/acl-getuser/
+func (client *GlideClusterClient) AclGetUserWithRoute(
+	ctx context.Context,
+	username string,
+	route options.RouteOption,
+) (ClusterValue[Result[AclUser]], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.AclGetUser, []string{username}, routeOrDefault(route, config.AllNodes))
+	if err != nil {
+		return createEmptyClusterValue[Result[AclUser]](), err
+	}
+	return handleClusterValueResponse(result, routeOrDefault(route, config.AllNodes), convertAclUser)
+}
+
+// Lists the users and their rules on all nodes, in the format of the ACL file.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the description of every user on every node, such as "user default on nopass ~* &* +@all".
+//
+// [valkey.io]: https://valkey.io/commands/acl-list/
+func (client *GlideClusterClient) AclList(ctx context.Context) (ClusterValue[[]string], error) {
+	return client.AclListWithRoute(ctx, options.RouteOption{})
+}
+
+// Lists the users and their rules, in the format of the ACL file.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route, or to all nodes when it isn't set.
+//
+// Return value:
+//
+//	A [ClusterValue] with the description of every user, such as "user default on nopass ~* &* +@all".
+//
+// [valkey.io]: https://valkey.io/commands/acl-list/
+func (client *GlideClusterClient) AclListWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[[]string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.AclList, []string{}, routeOrDefault(route, config.AllNodes))
+	if err != nil {
+		return createEmptyClusterValue[[]string](), err
+	}
+	return handleClusterValueResponse(result, routeOrDefault(route, config.AllNodes), convertStringArrayValue)
+}
+
+// Lists the names of the users on all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the user names of every node.
+//
+// [valkey.io]: https://valkey.io/commands/acl-users/
+func (client *GlideClusterClient) AclUsers(ctx context.Context) (ClusterValue[[]string], error) {
+	return client.AclUsersWithRoute(ctx, options.RouteOption{})
+}
+
+// Lists the names of the users.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route, or to all nodes when it isn't set.
+//
+// Return value:
+//
+//	A [ClusterValue] with the user names.
+//
+// [valkey.io]: https://valkey.io/commands/acl-users/
+func (client *GlideClusterClient) AclUsersWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[[]string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.AclUsers, []string{}, routeOrDefault(route, config.AllNodes))
+	if err != nil {
+		return createEmptyClusterValue[[]string](), err
+	}
+	return handleClusterValueResponse(result, routeOrDefault(route, config.AllNodes), convertStringArrayValue)
+}
+
+// Gets the name of the user the connections to all nodes are authenticated as.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the name of the user on every node.
+//
+// [valkey.io]: https://valkey.io/commands/acl-whoami/
+func (client *GlideClusterClient) AclWhoAmI(ctx context.Context) (ClusterValue[string], error) {
+	return client.AclWhoAmIWithRoute(ctx, options.RouteOption{})
+}
+
+// Gets the name of the user the connection is authenticated as.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route, or to all nodes when it isn't set.
+//
+// Return value:
+//
+//	A [ClusterValue] with the name of the user.
+//
+// [valkey.io]: https://valkey.io/commands/acl-whoami/
+func (client *GlideClusterClient) AclWhoAmIWithRoute(
+	ctx context.Context,
+	route options.RouteOption,
+) (ClusterValue[string], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.AclWhoami, []string{}, routeOrDefault(route, config.AllNodes))
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(result, routeOrDefault(route, config.AllNodes), convertStringValue)
+}
+
+// Checks on all nodes whether a user may call a command with the given arguments, without calling it.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//	command - The name of the command.
+//	args - The arguments of the command.
+//
+// Return value:
+//
+//	A [ClusterValue] with `OK` for the nodes where the user may call the command, or the reason the command would be
+//	denied otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/acl-dryrun/
+func (client *GlideClusterClient) AclDryRun(
+	ctx context.Context,
+	username string,
+	command string,
+	args []string,
+) (ClusterValue[string], error) {
+	return client.AclDryRunWithRoute(ctx, username, command, args, options.RouteOption{})
+}
+
+// Checks whether a user may call a command with the given arguments, without calling it.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	username - The name of the user.
+//	command - The name of the command.
+//	args - The arguments of the command.
+//	route - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route, or to all nodes when it isn't set.
+//
+// Return value:
+//
+//	A [ClusterValue] with `OK` when the user may call the command, or the reason the command would be denied otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/acl-dryrun/
+func (client *GlideClusterClient) AclDryRunWithRoute(
+	ctx context.Context,
+	username string,
+	command string,
+	args []string,
+	route options.RouteOption,
+) (ClusterValue[string], error) {
+	result, err := client.executeCommandWithRoute(
+		ctx,
+		C.AclDryRun,
+		append([]string{username, command}, args...),
+		routeOrDefault(route, config.AllNodes),
+	)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(result, routeOrDefault(route, config.AllNodes), convertStringValue)
+}
+
+// Reloads the users of all nodes from the ACL files configured on the nodes, replacing all the users. The users of a
+// node are left unchanged when its file is invalid.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` when all nodes reloaded their users. An error is returned when a node isn't configured with an ACL file, or when
+//	its file is invalid.
+//
+// [valkey.io]: https://valkey.io/commands/acl-load/
+func (client *GlideClusterClient) AclLoad(ctx context.Context) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.AclLoad, []string{}, config.AllNodes)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleAllNodesOkResponse(result)
+}
+
+// Gets the 10 most recent security events of all nodes, such as denied commands and failed authentications.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [AclLogEntry] for every node, from the most recent.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *GlideClusterClient) AclLog(ctx context.Context) (ClusterValue[[]AclLogEntry], error) {
+	return client.AclLogWithOptions(ctx, options.ClusterAclLogOptions{})
+}
+
+// Gets the most recent security events, such as denied commands and failed authentications.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The maximum number of entries to return of every node, and the nodes to get them from, all nodes by default.
+//	       See [options.ClusterAclLogOptions].
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [AclLogEntry], from the most recent.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *GlideClusterClient) AclLogWithOptions(
+	ctx context.Context,
+	opts options.ClusterAclLogOptions,
+) (ClusterValue[[]AclLogEntry], error) {
+	args, err := opts.AclLogOptions.ToArgs()
+	if err != nil {
+		return createEmptyClusterValue[[]AclLogEntry](), err
+	}
+	var route options.RouteOption
+	if opts.RouteOption != nil {
+		route = *opts.RouteOption
+	}
+	result, err := client.executeCommandWithRoute(ctx, C.AclLog, args, routeOrDefault(route, config.AllNodes))
+	if err != nil {
+		return createEmptyClusterValue[[]AclLogEntry](), err
+	}
+	return handleClusterValueResponse(result, routeOrDefault(route, config.AllNodes), convertAclLog)
+}
+
+// Clears the security events logged by all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/acl-log/
+func (client *GlideClusterClient) AclLogReset(ctx context.Context) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.AclLog, []string{options.ResetKeyword}, config.AllNodes)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleAllNodesOkResponse(result)
+}
+
+// Gets information about the current connection to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the [ClientInfo] of the connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *GlideClusterClient) ClientInfo(ctx context.Context) (ClusterValue[ClientInfo], error) {
+	return client.ClientInfoWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets information about the current connection.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	A [ClusterValue] with the [ClientInfo] of the connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-info/
+func (client *GlideClusterClient) ClientInfoWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (ClusterValue[ClientInfo], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientInfo, []string{}, opts.Route)
+	if err != nil {
+		return createEmptyClusterValue[ClientInfo](), err
+	}
+	return handleClusterValueResponse(result, opts.Route, convertClientInfo)
+}
+
+// Lists the connections to a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the [ClientInfo] of every connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-list/
+func (client *GlideClusterClient) ClientList(ctx context.Context) (ClusterValue[[]ClientInfo], error) {
+	return client.ClientListWithOptions(ctx, options.RouteOption{})
+}
+
+// Lists the connections to the nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	A [ClusterValue] with the [ClientInfo] of every connection.
+//
+// [valkey.io]: https://valkey.io/commands/client-list/
+func (client *GlideClusterClient) ClientListWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (ClusterValue[[]ClientInfo], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientList, []string{}, opts.Route)
+	if err != nil {
+		return createEmptyClusterValue[[]ClientInfo](), err
+	}
+	return handleClusterValueResponse(result, opts.Route, convertClientList)
+}
+
+// Closes the connections to a random node matching the filters.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	killOptions - The filters of the connections to close, at least one must be set. See [options.ClientKillOptions].
+//
+// Return value:
+//
+//	The number of connections that were closed.
+//
+// [valkey.io]: https://valkey.io/commands/client-kill/
+func (client *GlideClusterClient) ClientKill(ctx context.Context, killOptions *options.ClientKillOptions) (int64, error) {
+	return client.ClientKillWithOptions(ctx, killOptions, options.RouteOption{})
+}
+
+// Closes the connections matching the filters.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	killOptions - The filters of the connections to close, at least one must be set. See [options.ClientKillOptions].
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	The number of connections that were closed, summed over the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/client-kill/
+func (client *GlideClusterClient) ClientKillWithOptions(
+	ctx context.Context,
+	killOptions *options.ClientKillOptions,
+	opts options.RouteOption,
+) (int64, error) {
+	args, err := killOptions.ToArgs()
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	result, err := client.executeCommandWithRoute(ctx, C.ClientKill, args, opts.Route)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	killed, err := handleClusterValueResponse(result, opts.Route, convertIntValue)
+	if err != nil || killed.IsSingleValue() {
+		return killed.SingleValue(), err
+	}
+	var total int64
+	for _, count := range killed.MultiValue() {
+		total += count
+	}
+	return total, nil
+}
+
+// Suspends the commands of all the clients of a random node, except replicas, for the given time.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	timeoutMillis - The duration of the pause in milliseconds.
+//	mode - The commands to suspend: options.PauseWrite for the commands which may write, or options.PauseAll.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *GlideClusterClient) ClientPause(
+	ctx context.Context,
+	timeoutMillis int64,
+	mode options.ClientPauseMode,
+) (string, error) {
+	return client.ClientPauseWithOptions(ctx, timeoutMillis, mode, options.RouteOption{})
+}
+
+// Suspends the commands of all the clients, except replicas, for the given time.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	timeoutMillis - The duration of the pause in milliseconds.
+//	mode - The commands to suspend: options.PauseWrite for the commands which may write, or options.PauseAll.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`OK` when all the nodes succeeded.
+//
+// [valkey.io]: https://valkey.io/commands/client-pause/
+func (client *GlideClusterClient) ClientPauseWithOptions(
+	ctx context.Context,
+	timeoutMillis int64,
+	mode options.ClientPauseMode,
+	opts options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientPause, clientPauseArgs(timeoutMillis, mode), opts.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleAllNodesOkResponse(result)
+}
+
+// Resumes the commands of a random node suspended by [GlideClusterClient.ClientPause].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *GlideClusterClient) ClientUnpause(ctx context.Context) (string, error) {
+	return client.ClientUnpauseWithOptions(ctx, options.RouteOption{})
+}
+
+// Resumes the commands suspended by [GlideClusterClient.ClientPauseWithOptions].
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`OK` when all the nodes succeeded.
+//
+// [valkey.io]: https://valkey.io/commands/client-unpause/
+func (client *GlideClusterClient) ClientUnpauseWithOptions(ctx context.Context, opts options.RouteOption) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientUnpause, []string{}, opts.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleAllNodesOkResponse(result)
+}
+
+// Sets whether the current connection to a random node is spared when the node evicts connections to free memory.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - Whether the connection is spared.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-evict/
+func (client *GlideClusterClient) ClientNoEvict(ctx context.Context, enabled bool) (string, error) {
+	return client.ClientNoEvictWithOptions(ctx, enabled, options.RouteOption{})
+}
+
+// Sets whether the current connections are spared when the nodes evict connections to free memory.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - Whether the connections are spared.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`OK` when all the nodes succeeded.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-evict/
+func (client *GlideClusterClient) ClientNoEvictWithOptions(
+	ctx context.Context,
+	enabled bool,
+	opts options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientNoEvict, []string{onOffArg(enabled)}, opts.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleAllNodesOkResponse(result)
+}
+
+// Sets whether the commands of the current connection to a random node leave the last access time of the keys unchanged.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - Whether the last access time of the keys is left unchanged.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-touch/
+func (client *GlideClusterClient) ClientNoTouch(ctx context.Context, enabled bool) (string, error) {
+	return client.ClientNoTouchWithOptions(ctx, enabled, options.RouteOption{})
+}
+
+// Sets whether the commands of the current connections leave the last access time of the keys unchanged.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	enabled - Whether the last access time of the keys is left unchanged.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`OK` when all the nodes succeeded.
+//
+// [valkey.io]: https://valkey.io/commands/client-no-touch/
+func (client *GlideClusterClient) ClientNoTouchWithOptions(
+	ctx context.Context,
+	enabled bool,
+	opts options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientNoTouch, []string{onOffArg(enabled)}, opts.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleAllNodesOkResponse(result)
+}
+
+// Unblocks a connection to a random node blocked by a blocking command, such as `BLPop`. The connections of the client
+// itself can only be unblocked by another client, since the command would wait for the blocking command to complete.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	clientId - The id of the connection to unblock, as returned by `ClientId`.
+//	withError - Whether the blocking command fails with an error, rather than returning as if it timed out.
+//
+// Return value:
+//
+//	`true` when the connection was blocked and is unblocked, `false` otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/client-unblock/
+func (client *GlideClusterClient) ClientUnblock(ctx context.Context, clientId int64, withError bool) (bool, error) {
+	return client.ClientUnblockWithOptions(ctx, clientId, withError, options.RouteOption{})
+}
+
+// Unblocks a connection blocked by a blocking command, such as `BLPop`. The connections of the client itself can only be
+// unblocked by another client, since the command would wait for the blocking command to complete.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	clientId - The id of the connection to unblock, as returned by `ClientId`.
+//	withError - Whether the blocking command fails with an error, rather than returning as if it timed out.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`true` when the connection was blocked on one of the nodes and is unblocked, `false` otherwise.
+//
+// [valkey.io]: https://valkey.io/commands/client-unblock/
+func (client *GlideClusterClient) ClientUnblockWithOptions(
+	ctx context.Context,
+	clientId int64,
+	withError bool,
+	opts options.RouteOption,
+) (bool, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientUnblock, clientUnblockArgs(clientId, withError), opts.Route)
+	if err != nil {
+		return defaultBoolResponse, err
+	}
+	unblocked, err := handleClusterValueResponse(result, opts.Route, convertBoolValue)
+	if err != nil || unblocked.IsSingleValue() {
+		return unblocked.SingleValue(), err
+	}
+	for _, nodeUnblocked := range unblocked.MultiValue() {
+		if nodeUnblocked {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Sets an attribute of the current connections to all nodes, reported by [GlideClusterClient.ClientInfo] and
+// [GlideClusterClient.ClientList].
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	attribute - The attribute to set: options.ClientLibName or options.ClientLibVersion.
+//	value - The value of the attribute.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/client-setinfo/
+func (client *GlideClusterClient) ClientSetInfo(
+	ctx context.Context,
+	attribute options.ClientInfoAttribute,
+	value string,
+) (string, error) {
+	return client.ClientSetInfoWithOptions(ctx, attribute, value, options.RouteOption{})
+}
+
+// Sets an attribute of the current connections, reported by [GlideClusterClient.ClientInfo] and
+// [GlideClusterClient.ClientList].
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	attribute - The attribute to set: options.ClientLibName or options.ClientLibVersion.
+//	value - The value of the attribute.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	`OK` when all the nodes succeeded.
+//
+// [valkey.io]: https://valkey.io/commands/client-setinfo/
+func (client *GlideClusterClient) ClientSetInfoWithOptions(
+	ctx context.Context,
+	attribute options.ClientInfoAttribute,
+	value string,
+	opts options.RouteOption,
+) (string, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientSetInfo, []string{string(attribute), value}, opts.Route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleAllNodesOkResponse(result)
+}
+
+// Gets the id of the connection the invalidation messages of the client side caching of the current connection to a
+// random node are redirected to.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the id of the connection, 0 when the messages are sent to the current connection, or -1 when
+//	client side caching is disabled.
+//
+// [valkey.io]: https://valkey.io/commands/client-getredir/
+func (client *GlideClusterClient) ClientGetRedir(ctx context.Context) (ClusterValue[int64], error) {
+	return client.ClientGetRedirWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the id of the connection the invalidation messages of the client side caching of the current connection are
+// redirected to.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - Specifies the routing configuration for the command. The client will route the
+//	        command to the nodes defined by route.
+//
+// Return value:
+//
+//	A [ClusterValue] with the id of the connection, 0 when the messages are sent to the current connection, or -1 when
+//	client side caching is disabled.
+//
+// [valkey.io]: https://valkey.io/commands/client-getredir/
+func (client *GlideClusterClient) ClientGetRedirWithOptions(
+	ctx context.Context,
+	opts options.RouteOption,
+) (ClusterValue[int64], error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClientGetRedir, []string{}, opts.Route)
+	if err != nil {
+		return createEmptyClusterValue[int64](), err
+	}
+	return handleClusterValueResponse(result, opts.Route, convertIntValue)
+}
+
+// executeOnEachNode sends a command separately to every node of a multi-node route, and converts the response of every
+// node with convert. It is used for the commands whose responses the core aggregates when they are sent to multiple nodes.
+//
+// The nodes are taken from the CLUSTER NODES reply of a random node. The nodes the client isn't connected to, such as the
+// primaries which serve no slots yet during a scale-out and their replicas, are left out of the result, as well as the
+// nodes which can't be reached. The command only fails when none of the nodes can be reached, or when a node fails it.
+func executeOnEachNode[T any](
+	ctx context.Context,
+	client *GlideClusterClient,
+	requestType C.RequestType,
+	args []string,
+	route config.Route,
+	convert func(interface{}) (T, error),
+) (ClusterValue[T], error) {
+	if route == nil || !route.IsMultiNode() {
+		result, err := client.executeCommandWithRoute(ctx, requestType, args, route)
+		if err != nil {
+			return createEmptyClusterValue[T](), err
+		}
+		return handleClusterValueResponse(result, route, convert)
+	}
+	clusterNodes, err := client.ClusterNodes(ctx)
+	if err != nil {
+		return createEmptyClusterValue[T](), err
+	}
+	nodes := eachNodeTargets(clusterNodes.SingleValue(), route == config.AllPrimaries)
+
+	var (
+		wg             sync.WaitGroup
+		mu             sync.Mutex
+		firstErr       error
+		unreachableErr error
+	)
+	values := make(map[string]T, len(nodes))
+	for _, node := range nodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			address, value, err := executeOnNode(ctx, client, requestType, args, node, convert)
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case isUnreachableNodeError(err):
+				unreachableErr = err
+			case err != nil:
+				if firstErr == nil {
+					firstErr = err
+				}
+			default:
+				values[address] = value
+			}
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return createEmptyClusterValue[T](), firstErr
+	}
+	if len(values) == 0 {
+		if unreachableErr == nil {
+			unreachableErr = &errors.ConnectionError{Msg: "the nodes of the cluster are unknown"}
+		}
+		return createEmptyClusterValue[T](), unreachableErr
+	}
+	return createClusterMultiValue(values), nil
+}
+
+// executeOnNode sends a command to a node of the cluster, and returns the address the node was reached at with its
+// converted response. The node is reached at its IP address, or at its hostname when the client is connected to it by
+// hostname.
+func executeOnNode[T any](
+	ctx context.Context,
+	client *GlideClusterClient,
+	requestType C.RequestType,
+	args []string,
+	node ClusterNode,
+	convert func(interface{}) (T, error),
+) (string, T, error) {
+	var empty T
+	addresses := []string{node.Address}
+	if node.Hostname != "" {
+		if _, port, err := net.SplitHostPort(node.Address); err == nil {
+			addresses = append(addresses, net.JoinHostPort(node.Hostname, port))
+		}
+	}
+	var err error
+	for _, address := range addresses {
+		nodeRoute, ok := nodeRoute(address)
+		if !ok {
+			return "", empty, &errors.RequestError{Msg: fmt.Sprintf("invalid node address %q", address)}
+		}
+		var result *C.struct_CommandResponse
+		result, err = client.executeCommandWithRoute(ctx, requestType, args, nodeRoute)
+		if isUnreachableNodeError(err) {
+			continue
+		}
+		if err != nil {
+			return "", empty, err
+		}
+		data, err := handleInterfaceResponse(result)
+		if err != nil {
+			return "", empty, err
+		}
+		value, err := convert(data)
+		return address, value, err
+	}
+	return "", empty, err
+}
+
+// isUnreachableNodeError returns whether err reports that a node can't be reached, which is also how the core reports a
+// node the client isn't connected to.
+func isUnreachableNodeError(err error) bool {
+	return goErrors.As(err, new(*errors.DisconnectError)) || goErrors.As(err, new(*errors.ConnectionError))
+}
+
+// eachNodeTargets returns the nodes a command sent to each node of the cluster is sent to. Only the primaries are
+// returned when primariesOnly is set. Failing nodes and nodes which aren't part of the cluster yet are left out, since no
+// command can reach them anyway.
+func eachNodeTargets(nodes []ClusterNode, primariesOnly bool) []ClusterNode {
+	return slices.DeleteFunc(slices.Clone(nodes), func(node ClusterNode) bool {
+		return node.IsFailing() || slices.Contains(node.Flags, "handshake") || slices.Contains(node.Flags, "noaddr") ||
+			(primariesOnly && !node.IsPrimary())
+	})
+}
+
+// Gets the 10 most recent commands of every node which exceeded the execution time configured by
+// `slowlog-log-slower-than`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [SlowLogEntry] of every node, from the most recent, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-get/
+func (client *GlideClusterClient) SlowLogGet(ctx context.Context) (ClusterValue[[]SlowLogEntry], error) {
+	return executeOnEachNode(ctx, client, C.SlowLogGet, []string{}, config.AllNodes, convertSlowLog)
+}
+
+// Gets the most recent commands which exceeded the execution time configured by `slowlog-log-slower-than`.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The maximum number of entries to return of every node, and the nodes to get them from, all nodes by default.
+//	       See [options.ClusterSlowLogOptions].
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [SlowLogEntry], from the most recent. The value is keyed by node address when the
+//	entries are returned from multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-get/
+func (client *GlideClusterClient) SlowLogGetWithOptions(
+	ctx context.Context,
+	opts options.ClusterSlowLogOptions,
+) (ClusterValue[[]SlowLogEntry], error) {
+	args, err := opts.SlowLogOptions.ToArgs()
+	if err != nil {
+		return createEmptyClusterValue[[]SlowLogEntry](), err
+	}
+	var route options.RouteOption
+	if opts.RouteOption != nil {
+		route = *opts.RouteOption
+	}
+	return executeOnEachNode(ctx, client, C.SlowLogGet, args, routeOrDefault(route, config.AllNodes), convertSlowLog)
+}
+
+// Gets the number of entries of the slow log of every node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the number of entries of every node, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-len/
+func (client *GlideClusterClient) SlowLogLen(ctx context.Context) (ClusterValue[int64], error) {
+	return executeOnEachNode(ctx, client, C.SlowLogLen, []string{}, config.AllNodes, convertIntValue)
+}
+
+// Gets the number of entries of the slow log.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with the number of entries. The value is keyed by node address when the command is routed to
+//	multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-len/
+func (client *GlideClusterClient) SlowLogLenWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[int64], error) {
+	route := routeOrDefault(routeOption, config.AllNodes)
+	return executeOnEachNode(ctx, client, C.SlowLogLen, []string{}, route, convertIntValue)
+}
+
+// Clears the slow log of all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-reset/
+func (client *GlideClusterClient) SlowLogReset(ctx context.Context) (string, error) {
+	return client.SlowLogResetWithOptions(ctx, options.RouteOption{})
+}
+
+// Clears the slow log.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/slowlog-reset/
+func (client *GlideClusterClient) SlowLogResetWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (string, error) {
+	route := routeOrDefault(routeOption, config.AllNodes)
+	result, err := client.executeCommandWithRoute(ctx, C.SlowLogReset, []string{}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleAllNodesOkResponse(result)
+}
+
+// Gets the latest latency spike of every event monitored by every node. Events are monitored when
+// `latency-monitor-threshold` is configured.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [LatencyEvent] of every node, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/latency-latest/
+func (client *GlideClusterClient) LatencyLatest(ctx context.Context) (ClusterValue[[]LatencyEvent], error) {
+	return client.LatencyLatestWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the latest latency spike of every event monitored by the server. Events are monitored when
+// `latency-monitor-threshold` is configured.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [LatencyEvent]. The value is keyed by node address when the command is routed to
+//	multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/latency-latest/
+func (client *GlideClusterClient) LatencyLatestWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[[]LatencyEvent], error) {
+	route := routeOrDefault(routeOption, config.AllNodes)
+	result, err := client.executeCommandWithRoute(ctx, C.LatencyLatest, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[[]LatencyEvent](), err
+	}
+	return handleClusterValueResponse(result, route, convertLatencyLatest)
+}
+
+// Gets the latency spikes of an event of every node, up to the 160 most recent.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	event - The name of the event, as returned by [GlideClusterClient.LatencyLatest].
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [LatencySample] of every node, from the oldest, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/latency-history/
+func (client *GlideClusterClient) LatencyHistory(ctx context.Context, event string) (ClusterValue[[]LatencySample], error) {
+	return client.LatencyHistoryWithOptions(ctx, event, options.RouteOption{})
+}
+
+// Gets the latency spikes of an event, up to the 160 most recent.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	event - The name of the event, as returned by [GlideClusterClient.LatencyLatest].
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [LatencySample], from the oldest. The value is keyed by node address when the
+//	command is routed to multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/latency-history/
+func (client *GlideClusterClient) LatencyHistoryWithOptions(
+	ctx context.Context,
+	event string,
+	routeOption options.RouteOption,
+) (ClusterValue[[]LatencySample], error) {
+	route := routeOrDefault(routeOption, config.AllNodes)
+	result, err := client.executeCommandWithRoute(ctx, C.LatencyHistory, []string{event}, route)
+	if err != nil {
+		return createEmptyClusterValue[[]LatencySample](), err
+	}
+	return handleClusterValueResponse(result, route, convertLatencyHistory)
+}
+
+// Gets a human readable analysis of the latency spikes of every node, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the report of every node, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/latency-doctor/
+func (client *GlideClusterClient) LatencyDoctor(ctx context.Context) (ClusterValue[string], error) {
+	return client.LatencyDoctorWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets a human readable analysis of the latency spikes of the server, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with the report. The value is keyed by node address when the command is routed to multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/latency-doctor/
+func (client *GlideClusterClient) LatencyDoctorWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[string], error) {
+	route := routeOrDefault(routeOption, config.AllNodes)
+	result, err := client.executeCommandWithRoute(ctx, C.LatencyDoctor, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(result, route, convertStringValue)
+}
+
+// Clears the latency spikes of events on all nodes.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	events - The names of the events to clear, or an empty slice to clear all the events.
+//
+// Return value:
+//
+//	The number of events that were cleared, summed over all nodes.
+//
+// [valkey.io]: https://valkey.io/commands/latency-reset/
+func (client *GlideClusterClient) LatencyReset(ctx context.Context, events []string) (int64, error) {
+	return client.LatencyResetWithOptions(ctx, events, options.RouteOption{})
+}
+
+// Clears the latency spikes of events.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	events - The names of the events to clear, or an empty slice to clear all the events.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	The number of events that were cleared, summed over the nodes.
+//
+// [valkey.io]: https://valkey.io/commands/latency-reset/
+func (client *GlideClusterClient) LatencyResetWithOptions(
+	ctx context.Context,
+	events []string,
+	routeOption options.RouteOption,
+) (int64, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.LatencyReset, events, routeOrDefault(routeOption, config.AllNodes))
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleAllNodesSumResponse(result)
+}
+
+// Gets the latency distribution of commands on every node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	commands - The names of the commands, or an empty slice for all the commands which were called.
+//
+// Return value:
+//
+//	A [ClusterValue] with a map of [CommandHistogram] keyed by command name of every node, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/latency-histogram/
+func (client *GlideClusterClient) LatencyHistogram(
+	ctx context.Context,
+	commands []string,
+) (ClusterValue[map[string]CommandHistogram], error) {
+	return client.LatencyHistogramWithOptions(ctx, commands, options.RouteOption{})
+}
+
+// Gets the latency distribution of commands.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	commands - The names of the commands, or an empty slice for all the commands which were called.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with a map of [CommandHistogram] keyed by command name. The value is keyed by node address when the
+//	command is routed to multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/latency-histogram/
+func (client *GlideClusterClient) LatencyHistogramWithOptions(
+	ctx context.Context,
+	commands []string,
+	routeOption options.RouteOption,
+) (ClusterValue[map[string]CommandHistogram], error) {
+	route := routeOrDefault(routeOption, config.AllNodes)
+	result, err := client.executeCommandWithRoute(ctx, C.LatencyHistogram, commands, route)
+	if err != nil {
+		return createEmptyClusterValue[map[string]CommandHistogram](), err
+	}
+	return handleClusterValueResponse(result, route, convertLatencyHistogram)
+}
+
+// Gets the memory usage of every primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the [MemoryStats] of every primary, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/memory-stats/
+func (client *GlideClusterClient) MemoryStats(ctx context.Context) (ClusterValue[MemoryStats], error) {
+	return client.MemoryStatsWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the memory usage of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all primaries by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with the [MemoryStats]. The value is keyed by node address when the command is routed to multiple
+//	nodes.
+//
+// [valkey.io]: https://valkey.io/commands/memory-stats/
+func (client *GlideClusterClient) MemoryStatsWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[MemoryStats], error) {
+	route := routeOrDefault(routeOption, config.AllPrimaries)
+	result, err := client.executeCommandWithRoute(ctx, C.MemoryStats, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[MemoryStats](), err
+	}
+	return handleClusterValueResponse(result, route, convertMemoryStats)
+}
+
+// Gets a human readable analysis of the memory usage of every primary, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the report of every primary, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/memory-doctor/
+func (client *GlideClusterClient) MemoryDoctor(ctx context.Context) (ClusterValue[string], error) {
+	return client.MemoryDoctorWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets a human readable analysis of the memory usage of the server, with possible remedies.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all primaries by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with the report. The value is keyed by node address when the command is routed to multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/memory-doctor/
+func (client *GlideClusterClient) MemoryDoctorWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[string], error) {
+	route := routeOrDefault(routeOption, config.AllPrimaries)
+	result, err := client.executeCommandWithRoute(ctx, C.MemoryDoctor, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(result, route, convertStringValue)
+}
+
+// Gets the internal statistics of the memory allocator of every primary.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the statistics of every primary, in the format of the memory allocator, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/memory-malloc-stats/
+func (client *GlideClusterClient) MemoryMallocStats(ctx context.Context) (ClusterValue[string], error) {
+	return client.MemoryMallocStatsWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the internal statistics of the memory allocator of the server.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all primaries by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with the statistics, in the format of the memory allocator. The value is keyed by node address
+//	when the command is routed to multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/memory-malloc-stats/
+func (client *GlideClusterClient) MemoryMallocStatsWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[string], error) {
+	route := routeOrDefault(routeOption, config.AllPrimaries)
+	result, err := client.executeCommandWithRoute(ctx, C.MemoryMallocStats, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(result, route, convertStringValue)
+}
+
+// Asks the memory allocator of every primary to release the memory it doesn't use.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/memory-purge/
+func (client *GlideClusterClient) MemoryPurge(ctx context.Context) (string, error) {
+	return client.MemoryPurgeWithOptions(ctx, options.RouteOption{})
+}
+
+// Asks the memory allocator of the server to release the memory it doesn't use.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all primaries by default.
+//
+// Return value:
+//
+//	`OK` on success.
+//
+// [valkey.io]: https://valkey.io/commands/memory-purge/
+func (client *GlideClusterClient) MemoryPurgeWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (string, error) {
+	route := routeOrDefault(routeOption, config.AllPrimaries)
+	result, err := client.executeCommandWithRoute(ctx, C.MemoryPurge, []string{}, route)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return handleAllNodesOkResponse(result)
+}
+
+// Gets the state of the cluster as seen by a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the [ClusterState] seen by the node.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-info/
+func (client *GlideClusterClient) ClusterInfo(ctx context.Context) (ClusterValue[ClusterState], error) {
+	return client.ClusterInfoWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the state of the cluster as seen by a node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, a random node by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with the [ClusterState]. The value is keyed by node address when the command is routed to
+//	multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-info/
+func (client *GlideClusterClient) ClusterInfoWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[ClusterState], error) {
+	route := routeOrDefault(routeOption, config.RandomRoute)
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterInfo, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[ClusterState](), err
+	}
+	return handleClusterValueResponse(result, route, convertClusterState)
+}
+
+// Gets the nodes of the cluster as seen by a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [ClusterNode] seen by the node.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-nodes/
+func (client *GlideClusterClient) ClusterNodes(ctx context.Context) (ClusterValue[[]ClusterNode], error) {
+	return client.ClusterNodesWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the nodes of the cluster as seen by a node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, a random node by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [ClusterNode]. The value is keyed by node address when the command is routed to
+//	multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-nodes/
+func (client *GlideClusterClient) ClusterNodesWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[[]ClusterNode], error) {
+	route := routeOrDefault(routeOption, config.RandomRoute)
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterNodes, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[[]ClusterNode](), err
+	}
+	return handleClusterValueResponse(result, route, convertClusterNodes)
+}
+
+// Gets the shards of the cluster as seen by a random node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [ClusterShard] seen by the node.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-shards/
+func (client *GlideClusterClient) ClusterShards(ctx context.Context) (ClusterValue[[]ClusterShard], error) {
+	return client.ClusterShardsWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the shards of the cluster as seen by a node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, a random node by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [ClusterShard]. The value is keyed by node address when the command is routed to
+//	multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-shards/
+func (client *GlideClusterClient) ClusterShardsWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[[]ClusterShard], error) {
+	route := routeOrDefault(routeOption, config.RandomRoute)
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterShards, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[[]ClusterShard](), err
+	}
+	return handleClusterValueResponse(result, route, convertClusterShards)
+}
+
+// Gets the ranges of slots of the cluster and the nodes serving them, as seen by a random node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [ClusterSlotsEntry] seen by the node.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-slots/
+func (client *GlideClusterClient) ClusterSlots(ctx context.Context) (ClusterValue[[]ClusterSlotsEntry], error) {
+	return client.ClusterSlotsWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the ranges of slots of the cluster and the nodes serving them, as seen by a node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, a random node by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [ClusterSlotsEntry]. The value is keyed by node address when the command is
+//	routed to multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-slots/
+func (client *GlideClusterClient) ClusterSlotsWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[[]ClusterSlotsEntry], error) {
+	route := routeOrDefault(routeOption, config.RandomRoute)
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterSlots, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[[]ClusterSlotsEntry](), err
+	}
+	return handleClusterValueResponse(result, route, convertClusterSlots)
+}
+
+// Gets the id of every node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the id of every node, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myid/
+func (client *GlideClusterClient) ClusterMyId(ctx context.Context) (ClusterValue[string], error) {
+	return client.ClusterMyIdWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the id of the node.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with the id. The value is keyed by node address when the command is routed to multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myid/
+func (client *GlideClusterClient) ClusterMyIdWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[string], error) {
+	route := routeOrDefault(routeOption, config.AllNodes)
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterMyId, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(result, route, convertStringValue)
+}
+
+// Gets the id of the shard of every node.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with the shard id of every node, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myshardid/
+func (client *GlideClusterClient) ClusterMyShardId(ctx context.Context) (ClusterValue[string], error) {
+	return client.ClusterMyShardIdWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the id of the shard of the node.
+//
+// Since:
+//
+//	Valkey 7.2 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with the shard id. The value is keyed by node address when the command is routed to multiple
+//	nodes.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-myshardid/
+func (client *GlideClusterClient) ClusterMyShardIdWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[string], error) {
+	route := routeOrDefault(routeOption, config.AllNodes)
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterMyShardId, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[string](), err
+	}
+	return handleClusterValueResponse(result, route, convertStringValue)
+}
+
+// Gets the hash slot of a key.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	key - The key.
+//
+// Return value:
+//
+//	The hash slot of the key.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-keyslot/
+func (client *GlideClusterClient) ClusterKeySlot(ctx context.Context, key string) (int64, error) {
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterKeySlot, []string{key}, config.RandomRoute)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Gets the number of keys of a hash slot. The command is sent to the primary serving the slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The hash slot, from 0 to 16383.
+//
+// Return value:
+//
+//	The number of keys of the slot.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-countkeysinslot/
+func (client *GlideClusterClient) ClusterCountKeysInSlot(ctx context.Context, slot int64) (int64, error) {
+	route, err := slotRoute(slot)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterCountKeysInSlot, []string{utils.IntToString(slot)}, route)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return handleIntResponse(result)
+}
+
+// Gets keys of a hash slot. The command is sent to the primary serving the slot.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	slot - The hash slot, from 0 to 16383.
+//	count - The maximum number of keys to return.
+//
+// Return value:
+//
+//	An array of keys of the slot.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-getkeysinslot/
+func (client *GlideClusterClient) ClusterGetKeysInSlot(ctx context.Context, slot int64, count int64) ([]string, error) {
+	route, err := slotRoute(slot)
+	if err != nil {
+		return nil, err
+	}
+	args := []string{utils.IntToString(slot), utils.IntToString(count)}
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterGetKeysInSlot, args, route)
+	if err != nil {
+		return nil, err
+	}
+	return handleStringArrayResponse(result)
+}
+
+// slotRoute returns the route to the primary serving slot.
+func slotRoute(slot int64) (config.Route, error) {
+	if slot < 0 || slot >= slotCount {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("invalid slot %d, expected a slot from 0 to %d", slot, slotCount-1)}
+	}
+	return config.NewSlotIdRoute(config.SlotTypePrimary, int32(slot)), nil
+}
+
+// Gets the connections of the cluster bus of every node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [ClusterLink] of every node, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-links/
+func (client *GlideClusterClient) ClusterLinks(ctx context.Context) (ClusterValue[[]ClusterLink], error) {
+	return client.ClusterLinksWithOptions(ctx, options.RouteOption{})
+}
+
+// Gets the connections of the cluster bus of the node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all nodes by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [ClusterLink]. The value is keyed by node address when the command is routed to
+//	multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-links/
+func (client *GlideClusterClient) ClusterLinksWithOptions(
+	ctx context.Context,
+	routeOption options.RouteOption,
+) (ClusterValue[[]ClusterLink], error) {
+	route := routeOrDefault(routeOption, config.AllNodes)
+	result, err := client.executeCommandWithRoute(ctx, C.ClusterLinks, []string{}, route)
+	if err != nil {
+		return createEmptyClusterValue[[]ClusterLink](), err
+	}
+	return handleClusterValueResponse(result, route, convertClusterLinks)
+}
+
+// Gets the statistics of the slots served by every primary.
+//
+// Since:
+//
+//	Valkey 8.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The slots to return, either a range of slots or the slots ordered by a statistic. See
+//	       [options.SlotStatsOptions].
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [SlotStats] of every primary, keyed by node address.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-slot-stats/
+func (client *GlideClusterClient) ClusterSlotStats(
+	ctx context.Context,
+	opts options.SlotStatsOptions,
+) (ClusterValue[[]SlotStats], error) {
+	return client.ClusterSlotStatsWithOptions(ctx, opts, options.RouteOption{})
+}
+
+// Gets the statistics of the slots served by the node.
+//
+// Since:
+//
+//	Valkey 8.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//	opts - The slots to return, either a range of slots or the slots ordered by a statistic. See
+//	       [options.SlotStatsOptions].
+//	routeOption - Specifies the routing configuration for the command. The client will route the
+//	              command to the nodes defined by routeOption.Route, all primaries by default.
+//
+// Return value:
+//
+//	A [ClusterValue] with an array of [SlotStats]. The value is keyed by node address when the command is routed to
+//	multiple nodes.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-slot-stats/
+func (client *GlideClusterClient) ClusterSlotStatsWithOptions(
+	ctx context.Context,
+	opts options.SlotStatsOptions,
+	routeOption options.RouteOption,
+) (ClusterValue[[]SlotStats], error) {
+	optionArgs, err := opts.ToArgs()
+	if err != nil {
+		return createEmptyClusterValue[[]SlotStats](), err
+	}
+	// The core has no request type for CLUSTER SLOT-STATS, which is sent as a custom command.
+	args := append([]string{"CLUSTER", "SLOT-STATS"}, optionArgs...)
+	route := routeOrDefault(routeOption, config.AllPrimaries)
+	result, err := client.executeCommandWithRoute(ctx, C.CustomCommand, args, route)
+	if err != nil {
+		return createEmptyClusterValue[[]SlotStats](), err
+	}
+	return handleClusterValueResponse(result, route, convertSlotStats)
+}
+
+// Topology gets the shards of the cluster and the nodes serving them, as seen by a random node.
+//
+// Since:
+//
+//	Valkey 7.0 and above.
+//
+// See [valkey.io] for details.
+//
+// Parameters:
+//
+//	ctx - The context for controlling the command execution.
+//
+// Return value:
+//
+//	The [ClusterTopology] of the cluster.
+//
+// [valkey.io]: https://valkey.io/commands/cluster-shards/
+func (client *GlideClusterClient) Topology(ctx context.Context) (ClusterTopology, error) {
+	shards, err := client.ClusterShards(ctx)
+	if err != nil {
+		return ClusterTopology{}, err
+	}
+	return ClusterTopology{Shards: shards.SingleValue()}, nil
+}
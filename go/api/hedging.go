@@ -0,0 +1,211 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+const (
+	defaultHedgingMaxExtraLoad = 0.05
+	// hedgeLatencySamples is the number of recent latencies the hedging percentile is computed from.
+	hedgeLatencySamples = 128
+	// hedgeRecomputeInterval is the number of latencies observed between two computations of the hedging percentile.
+	hedgeRecomputeInterval = 16
+	// hedgeBudgetBurst caps the number of hedged requests that can be sent in a burst after a quiet period.
+	hedgeBudgetBurst = 10
+)
+
+// HedgingConfig configures hedged reads. When a read-only command hasn't completed within the hedging delay, the client
+// sends the same command a second time and returns whichever response arrives first, cancelling the other request.
+//
+// In cluster mode, when the [ReadFrom] strategy lets replicas serve the command, the second request is sent to another
+// node of the shard serving the command than the first one, preferring the replicas. Otherwise, such as with the
+// [Primary] strategy or in standalone mode, the second request is routed like the first one.
+//
+// The number of hedged requests is limited by a budget: at most MaxExtraLoad hedged requests are sent per read-only
+// command, on average.
+type HedgingConfig struct {
+	delay        time.Duration
+	percentile   float64
+	maxExtraLoad float64
+}
+
+// NewHedgingConfig returns a [HedgingConfig] that hedges read-only commands which haven't completed after delay, with at
+// most 5% extra requests. For further configuration, use the [HedgingConfig] With* methods.
+func NewHedgingConfig(delay time.Duration) *HedgingConfig {
+	return &HedgingConfig{delay: delay, maxExtraLoad: defaultHedgingMaxExtraLoad}
+}
+
+// WithPercentile sets the hedging delay to the given percentile, between 0 and 100 excluded, of the latencies recently
+// observed by the client. The delay passed to [NewHedgingConfig] is used until enough latencies were observed, and as a
+// lower bound afterwards. For example, a percentile of 95 hedges about the slowest 5% of the commands.
+func (config *HedgingConfig) WithPercentile(percentile float64) *HedgingConfig {
+	config.percentile = percentile
+	return config
+}
+
+// WithMaxExtraLoad sets the maximal ratio between the number of hedged requests and the number of read-only commands.
+func (config *HedgingConfig) WithMaxExtraLoad(maxExtraLoad float64) *HedgingConfig {
+	config.maxExtraLoad = maxExtraLoad
+	return config
+}
+
+func (config *HedgingConfig) validate() error {
+	if config.delay < 0 {
+		return &errors.ConfigurationError{Msg: fmt.Sprintf("invalid hedging delay %v", config.delay)}
+	}
+	if config.percentile < 0 || config.percentile >= 100 {
+		return &errors.ConfigurationError{Msg: fmt.Sprintf("invalid hedging percentile %v", config.percentile)}
+	}
+	if config.maxExtraLoad <= 0 || config.maxExtraLoad > 1 {
+		return &errors.ConfigurationError{Msg: fmt.Sprintf("invalid hedging max extra load %v", config.maxExtraLoad)}
+	}
+	return nil
+}
+
+// hedger tracks the latencies and the budget of hedged reads for a client.
+type hedger struct {
+	config HedgingConfig
+
+	mu             sync.Mutex
+	latencies      [hedgeLatencySamples]time.Duration
+	observed       int
+	percentileTime time.Duration
+	tokens         float64
+
+	// next spreads the first requests of the hedged commands between the replicas of a shard.
+	next atomic.Uint64
+}
+
+func newHedger(config HedgingConfig) *hedger {
+	return &hedger{config: config}
+}
+
+// delay returns the time to wait for a response before sending a hedged request.
+func (h *hedger) delay() time.Duration {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return max(h.config.delay, h.percentileTime)
+}
+
+// observe records the latency of a completed read-only command.
+func (h *hedger) observe(latency time.Duration) {
+	if h.config.percentile == 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.latencies[h.observed%hedgeLatencySamples] = latency
+	h.observed++
+	if h.observed >= hedgeLatencySamples && h.observed%hedgeRecomputeInterval == 0 {
+		sorted := h.latencies
+		slices.Sort(sorted[:])
+		h.percentileTime = sorted[int(h.config.percentile*hedgeLatencySamples/100)]
+	}
+}
+
+// deposit adds the budget earned by a read-only command.
+func (h *hedger) deposit() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tokens = min(h.tokens+h.config.maxExtraLoad, hedgeBudgetBurst)
+}
+
+// withdraw consumes the budget of a hedged request, and reports whether the budget allowed it.
+func (h *hedger) withdraw() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.tokens < 1 {
+		return false
+	}
+	h.tokens--
+	return true
+}
+
+// hedgeRoutes returns the routes of the first request and of the hedged request of a command. In cluster mode, when the
+// command is served by a single shard and the [ReadFrom] strategy lets its replicas serve it, both requests are routed
+// explicitly to different nodes of the shard. Otherwise, both requests are routed like the command.
+func (client *baseClient) hedgeRoutes(
+	ctx context.Context,
+	requestType protobuf.RequestType,
+	args []string,
+	route config.Route,
+) (config.Route, config.Route) {
+	if client.clusterSlots == nil {
+		return route, route
+	}
+	readFrom, ok := ctx.Value(readFromContextKey{}).(ReadFrom)
+	if !ok {
+		readFrom = client.readFrom
+	}
+	if readFrom == Primary {
+		return route, route
+	}
+	var slot int
+	switch route := route.(type) {
+	case nil:
+		if _, ok := firstKeyRequestTypes[requestType]; !ok || len(args) == 0 {
+			return nil, nil
+		}
+		slot = keyHashSlot(args[0])
+	case *config.SlotKeyRoute:
+		slot = keyHashSlot(route.SlotKey)
+	case *config.SlotIdRoute:
+		slot = int(route.SlotID)
+	default:
+		return route, route
+	}
+	shard := client.clusterSlots.shard(slot)
+	if shard == nil || len(shard.replicas) == 0 {
+		return route, route
+	}
+
+	// The first request follows the ReadFrom strategy when it picks a node itself, and goes to the next replica otherwise.
+	resolved, err := client.readFromRoute(ctx, requestType, args, route)
+	if err != nil || isPrimaryRoute(resolved) {
+		return route, route
+	}
+	if resolved == nil && (readFrom == AzAffinity || readFrom == AzAffinityReplicaAndPrimary) && client.clientAZ != "" {
+		resolved = client.zoneLocalRoute(slot, readFrom == AzAffinityReplicaAndPrimary)
+	}
+	nodes := append(slices.Clone(shard.replicas), shard.primary)
+	first := int(client.hedger.next.Add(1) % uint64(len(shard.replicas)))
+	if address, ok := resolved.(*config.ByAddressRoute); ok {
+		first = slices.Index(nodes, net.JoinHostPort(address.Host, strconv.Itoa(int(address.Port))))
+		if first < 0 {
+			return resolved, resolved
+		}
+	}
+	firstRoute, ok := nodeRoute(nodes[first])
+	if !ok {
+		return route, route
+	}
+	hedgeRoute, ok := nodeRoute(nodes[(first+1)%len(nodes)])
+	if !ok {
+		return firstRoute, firstRoute
+	}
+	return firstRoute, hedgeRoute
+}
+
+// isPrimaryRoute reports whether route leads to the primary serving a slot.
+func isPrimaryRoute(route config.Route) bool {
+	switch route := route.(type) {
+	case *config.SlotKeyRoute:
+		return route.SlotType == config.SlotTypePrimary
+	case *config.SlotIdRoute:
+		return route.SlotType == config.SlotTypePrimary
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+func TestHedger_PercentileDelay(t *testing.T) {
+	h := newHedger(*NewHedgingConfig(time.Millisecond).WithPercentile(90))
+	assert.Equal(t, time.Millisecond, h.delay())
+
+	for i := 0; i < hedgeLatencySamples; i++ {
+		h.observe(time.Duration(i%100) * 100 * time.Microsecond)
+	}
+	assert.Greater(t, h.delay(), 8*time.Millisecond)
+	assert.Less(t, h.delay(), 10*time.Millisecond)
+
+	// The configured delay is a lower bound.
+	h = newHedger(*NewHedgingConfig(time.Second).WithPercentile(90))
+	for i := 0; i < hedgeLatencySamples; i++ {
+		h.observe(time.Millisecond)
+	}
+	assert.Equal(t, time.Second, h.delay())
+}
+
+func TestHedger_Budget(t *testing.T) {
+	h := newHedger(*NewHedgingConfig(time.Millisecond).WithMaxExtraLoad(0.1))
+	hedged := 0
+	for i := 0; i < 1000; i++ {
+		h.deposit()
+		if h.withdraw() {
+			hedged++
+		}
+	}
+	assert.InDelta(t, 100, hedged, 1)
+
+	// The budget saved during a quiet period is capped.
+	h = newHedger(*NewHedgingConfig(time.Millisecond))
+	for i := 0; i < 10000; i++ {
+		h.deposit()
+	}
+	hedged = 0
+	for h.withdraw() {
+		hedged++
+	}
+	assert.Equal(t, hedgeBudgetBurst, hedged)
+}
+
+func TestHedgingConfig_Validate(t *testing.T) {
+	assert.NoError(t, NewHedgingConfig(time.Millisecond).WithPercentile(99).validate())
+	assert.IsType(t, &errors.ConfigurationError{}, NewHedgingConfig(-time.Millisecond).validate())
+	assert.IsType(t, &errors.ConfigurationError{}, NewHedgingConfig(time.Millisecond).WithPercentile(100).validate())
+	assert.IsType(t, &errors.ConfigurationError{}, NewHedgingConfig(time.Millisecond).WithMaxExtraLoad(0).validate())
+
+	_, err := NewGlideClientConfiguration().WithHedging(NewHedgingConfig(-time.Millisecond)).toProtobuf()
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+}
+
+func TestHedgeRoutes_DifferentNodes(t *testing.T) {
+	client := &baseClient{
+		clusterSlots: newTestClusterSlots(newFakeClock()),
+		readFrom:     PreferReplica,
+		hedger:       newHedger(*NewHedgingConfig(time.Millisecond)),
+	}
+	ctx := context.Background()
+
+	// "user1000" hashes to slot 3443, served by 10.0.0.1:6379 with the replicas 10.0.0.3:6379 and 10.0.0.4:6379.
+	firsts := map[string]bool{}
+	for i := 0; i < 4; i++ {
+		first, hedge := client.hedgeRoutes(ctx, protobuf.RequestType_Get, []string{"user1000"}, nil)
+		firstNode := first.(*config.ByAddressRoute)
+		hedgeNode := hedge.(*config.ByAddressRoute)
+		assert.NotEqual(t, firstNode.Host, hedgeNode.Host)
+		assert.Contains(t, []string{"10.0.0.3", "10.0.0.4"}, firstNode.Host)
+		firsts[firstNode.Host] = true
+	}
+	assert.Len(t, firsts, 2)
+
+	// A command routed to a node is hedged to the same node, while a command routed to the replicas of a slot is hedged
+	// to another node.
+	route := config.NewByAddressRoute("10.0.0.4", 6379)
+	first, hedge := client.hedgeRoutes(ctx, protobuf.RequestType_Get, []string{"user1000"}, route)
+	assert.Equal(t, route, first)
+	assert.Equal(t, route, hedge)
+	first, hedge = client.hedgeRoutes(ctx, protobuf.RequestType_CustomCommand, nil,
+		config.NewSlotKeyRoute(config.SlotTypeReplica, "user1000"))
+	assert.NotEqual(t, first.(*config.ByAddressRoute).Host, hedge.(*config.ByAddressRoute).Host)
+
+	// A shard without replicas and reads from the primary are hedged with the route of the command.
+	first, hedge = client.hedgeRoutes(context.Background(), protobuf.RequestType_Get, []string{"foo"}, nil)
+	assert.Nil(t, first)
+	assert.Nil(t, hedge)
+	first, hedge = client.hedgeRoutes(WithReadFrom(context.Background(), Primary), protobuf.RequestType_Get,
+		[]string{"user1000"}, nil)
+	assert.Nil(t, first)
+	assert.Nil(t, hedge)
+
+	// Standalone clients hedge with the route of the command.
+	client.clusterSlots = nil
+	first, hedge = client.hedgeRoutes(context.Background(), protobuf.RequestType_Get, []string{"user1000"}, nil)
+	assert.Nil(t, first)
+	assert.Nil(t, hedge)
+}
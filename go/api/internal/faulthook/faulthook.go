@@ -0,0 +1,19 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package faulthook connects the clients of package api to package faults, without exporting the hook evaluating the
+// commands of a client from package api.
+package faulthook
+
+import "context"
+
+// Hook evaluates a command before it is sent to the server. A non-nil error fails the command without sending it. Commands
+// are identified by their request type name, as in "HGet", or by the command name for custom commands, as in "HGET".
+type Hook func(ctx context.Context, command string, args []string) error
+
+var (
+	// Attach sets the hook of client, or removes it if hook is nil. It reports false if client isn't a client of package
+	// api. It is set by package api.
+	Attach func(client any, hook Hook) bool
+	// KeyHashSlot returns the hash slot of key. It is set by package api.
+	KeyHashSlot func(key string) int
+)
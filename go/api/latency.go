@@ -0,0 +1,104 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+const (
+	// latencyProbeInterval is the time between two latency measurements of every node.
+	latencyProbeInterval = time.Second
+	// latencySmoothing is the weight of a new measurement in the moving average of the latency of a node.
+	latencySmoothing = 0.3
+)
+
+// latencyTracker holds the latency measured for every node, as an exponentially weighted moving average.
+type latencyTracker struct {
+	mu        sync.RWMutex
+	latencies map[string]time.Duration
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{latencies: make(map[string]time.Duration)}
+}
+
+func (tracker *latencyTracker) record(node string, latency time.Duration) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	if average, ok := tracker.latencies[node]; ok {
+		latency = time.Duration(latencySmoothing*float64(latency) + (1-latencySmoothing)*float64(average))
+	}
+	tracker.latencies[node] = latency
+}
+
+// lowest returns the node with the lowest latency among nodes, or an empty string if none of them was measured.
+func (tracker *latencyTracker) lowest(nodes []string) string {
+	tracker.mu.RLock()
+	defer tracker.mu.RUnlock()
+	best := ""
+	var bestLatency time.Duration
+	for _, node := range nodes {
+		if latency, ok := tracker.latencies[node]; ok && (best == "" || latency < bestLatency) {
+			best, bestLatency = node, latency
+		}
+	}
+	return best
+}
+
+// retain drops the measurements of the nodes which are not in nodes.
+func (tracker *latencyTracker) retain(nodes []string) {
+	keep := make(map[string]struct{}, len(nodes))
+	for _, node := range nodes {
+		keep[node] = struct{}{}
+	}
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+	for node := range tracker.latencies {
+		if _, ok := keep[node]; !ok {
+			delete(tracker.latencies, node)
+		}
+	}
+}
+
+func (tracker *latencyTracker) snapshot() map[string]time.Duration {
+	tracker.mu.RLock()
+	defer tracker.mu.RUnlock()
+	latencies := make(map[string]time.Duration, len(tracker.latencies))
+	for node, latency := range tracker.latencies {
+		latencies[node] = latency
+	}
+	return latencies
+}
+
+// lowestLatencyRoute returns a route to the replica with the lowest latency among the replicas serving the key of a
+// read-only command, or nil when the command should be routed by the core.
+func (client *baseClient) lowestLatencyRoute(requestType protobuf.RequestType, args []string) config.Route {
+	if len(args) == 0 {
+		return nil
+	}
+	if _, ok := idempotentRequestTypes[requestType]; !ok {
+		return nil
+	}
+	if _, ok := firstKeyRequestTypes[requestType]; !ok {
+		return nil
+	}
+	return client.lowestLatencyReplicaRoute(keyHashSlot(args[0]))
+}
+
+// lowestLatencyReplicaRoute returns a route to the replica with the lowest latency among the replicas serving slot, or nil
+// if none of them was measured.
+func (client *baseClient) lowestLatencyReplicaRoute(slot int) config.Route {
+	shard := client.clusterSlots.shard(slot)
+	if shard == nil {
+		return nil
+	}
+	if route, ok := nodeRoute(client.latencies.lowest(shard.replicas)); ok {
+		return route
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+func TestLatencyTracker(t *testing.T) {
+	tracker := newLatencyTracker()
+	assert.Equal(t, "", tracker.lowest([]string{"a:1", "b:1"}))
+
+	tracker.record("a:1", 10*time.Millisecond)
+	tracker.record("b:1", 2*time.Millisecond)
+	assert.Equal(t, "b:1", tracker.lowest([]string{"a:1", "b:1"}))
+	assert.Equal(t, "a:1", tracker.lowest([]string{"a:1", "c:1"}))
+
+	// A single slow measurement is smoothed by the moving average.
+	tracker.record("b:1", 12*time.Millisecond)
+	assert.Equal(t, 5*time.Millisecond, tracker.snapshot()["b:1"])
+	assert.Equal(t, "b:1", tracker.lowest([]string{"a:1", "b:1"}))
+
+	tracker.retain([]string{"a:1"})
+	assert.Equal(t, map[string]time.Duration{"a:1": 10 * time.Millisecond}, tracker.snapshot())
+}
+
+func TestLowestLatencyRoute(t *testing.T) {
+	client := &baseClient{clusterSlots: newTestClusterSlots(newFakeClock()), latencies: newLatencyTracker()}
+
+	// "user1000" hashes to a slot served by the first shard, with replicas 10.0.0.3 and 10.0.0.4.
+	assert.Nil(t, client.lowestLatencyRoute(protobuf.RequestType_Get, []string{"user1000"}))
+
+	client.latencies.record("10.0.0.3:6379", 3*time.Millisecond)
+	client.latencies.record("10.0.0.4:6379", time.Millisecond)
+	assert.Equal(t, config.NewByAddressRoute("10.0.0.4", 6379),
+		client.lowestLatencyRoute(protobuf.RequestType_Get, []string{"user1000"}))
+
+	// Writes, keyless commands and keys served by a shard without replicas are routed by the core.
+	assert.Nil(t, client.lowestLatencyRoute(protobuf.RequestType_Set, []string{"user1000", "value"}))
+	assert.Nil(t, client.lowestLatencyRoute(protobuf.RequestType_Ping, nil))
+	assert.Nil(t, client.lowestLatencyRoute(protobuf.RequestType_Get, []string{"foo"}))
+}
+
+func TestLowestLatencyConfig(t *testing.T) {
+	request, err := NewGlideClusterClientConfiguration().WithReadFrom(LowestLatency).toProtobuf()
+	assert.NoError(t, err)
+	assert.Equal(t, protobuf.ReadFrom_PreferReplica, request.ReadFrom)
+
+	_, err = NewGlideClientConfiguration().WithReadFrom(LowestLatency).toProtobuf()
+	assert.Error(t, err)
+}
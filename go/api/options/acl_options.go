@@ -0,0 +1,242 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"github.com/valkey-io/valkey-glide/go/utils"
+)
+
+// AclRules is used by `AclSetUser` to build the rules of a user, which are applied in the order they were added.
+//
+// See [valkey.io] for the meaning of each rule.
+//
+// [valkey.io]: https://valkey.io/commands/acl-setuser/
+type AclRules struct {
+	rules []string
+	err   error
+}
+
+// NewAclRules creates empty rules. Setting a user without rules creates it when it doesn't exist, and leaves it unchanged
+// otherwise.
+func NewAclRules() *AclRules {
+	return &AclRules{}
+}
+
+func (rules *AclRules) add(rule string) *AclRules {
+	rules.rules = append(rules.rules, rule)
+	return rules
+}
+
+func (rules *AclRules) fail(err error) *AclRules {
+	if rules.err == nil {
+		rules.err = err
+	}
+	return rules
+}
+
+// On enables the user, allowing to authenticate as it.
+func (rules *AclRules) On() *AclRules {
+	return rules.add("on")
+}
+
+// Off disables the user. Connections already authenticated as the user keep working.
+func (rules *AclRules) Off() *AclRules {
+	return rules.add("off")
+}
+
+// AddPassword adds a valid password for the user.
+func (rules *AclRules) AddPassword(password string) *AclRules {
+	return rules.add(">" + password)
+}
+
+// RemovePassword removes a password of the user.
+func (rules *AclRules) RemovePassword(password string) *AclRules {
+	return rules.add("<" + password)
+}
+
+// AddHashedPassword adds a valid password for the user, given as its hex encoded SHA-256 hash.
+func (rules *AclRules) AddHashedPassword(hash string) *AclRules {
+	if err := checkPasswordHash(hash); err != nil {
+		return rules.fail(err)
+	}
+	return rules.add("#" + strings.ToLower(hash))
+}
+
+// RemoveHashedPassword removes a password of the user, given as its hex encoded SHA-256 hash.
+func (rules *AclRules) RemoveHashedPassword(hash string) *AclRules {
+	if err := checkPasswordHash(hash); err != nil {
+		return rules.fail(err)
+	}
+	return rules.add("!" + strings.ToLower(hash))
+}
+
+// NoPass allows to authenticate as the user with any password, and removes its passwords.
+func (rules *AclRules) NoPass() *AclRules {
+	return rules.add("nopass")
+}
+
+// ResetPass removes the passwords of the user, and revokes NoPass.
+func (rules *AclRules) ResetPass() *AclRules {
+	return rules.add("resetpass")
+}
+
+// AllowKeys allows the commands of the user to read and write the keys matching the glob-style pattern.
+func (rules *AclRules) AllowKeys(pattern string) *AclRules {
+	return rules.add("~" + pattern)
+}
+
+// AllowReadKeys allows the commands of the user to read the keys matching the glob-style pattern.
+//
+// Since Valkey 7.0 and above.
+func (rules *AclRules) AllowReadKeys(pattern string) *AclRules {
+	return rules.add("%R~" + pattern)
+}
+
+// AllowWriteKeys allows the commands of the user to write the keys matching the glob-style pattern.
+//
+// Since Valkey 7.0 and above.
+func (rules *AclRules) AllowWriteKeys(pattern string) *AclRules {
+	return rules.add("%W~" + pattern)
+}
+
+// AllKeys allows the commands of the user to access all the keys.
+func (rules *AclRules) AllKeys() *AclRules {
+	return rules.add("allkeys")
+}
+
+// ResetKeys removes the key patterns of the user.
+func (rules *AclRules) ResetKeys() *AclRules {
+	return rules.add("resetkeys")
+}
+
+// AllowChannels allows the user to access the Pub/Sub channels matching the glob-style pattern.
+func (rules *AclRules) AllowChannels(pattern string) *AclRules {
+	return rules.add("&" + pattern)
+}
+
+// AllChannels allows the user to access all the Pub/Sub channels.
+func (rules *AclRules) AllChannels() *AclRules {
+	return rules.add("allchannels")
+}
+
+// ResetChannels removes the channel patterns of the user.
+func (rules *AclRules) ResetChannels() *AclRules {
+	return rules.add("resetchannels")
+}
+
+// AllowCommand allows the user to call the command, or the subcommand given as "command|subcommand".
+func (rules *AclRules) AllowCommand(command string) *AclRules {
+	return rules.add("+" + strings.ToLower(command))
+}
+
+// DenyCommand disallows the user to call the command, or the subcommand given as "command|subcommand".
+func (rules *AclRules) DenyCommand(command string) *AclRules {
+	return rules.add("-" + strings.ToLower(command))
+}
+
+// AllowCategory allows the user to call the commands of the category, as listed by `AclCat`.
+func (rules *AclRules) AllowCategory(category string) *AclRules {
+	return rules.add("+@" + strings.ToLower(category))
+}
+
+// DenyCategory disallows the user to call the commands of the category, as listed by `AclCat`.
+func (rules *AclRules) DenyCategory(category string) *AclRules {
+	return rules.add("-@" + strings.ToLower(category))
+}
+
+// AllCommands allows the user to call all the commands.
+func (rules *AclRules) AllCommands() *AclRules {
+	return rules.add("allcommands")
+}
+
+// NoCommands disallows the user to call any command.
+func (rules *AclRules) NoCommands() *AclRules {
+	return rules.add("nocommands")
+}
+
+// AddSelector adds a selector to the user. The user may call a command when its own rules, or the rules of one of its
+// selectors, allow it. Selectors only support the rules for keys, channels and commands.
+//
+// Since Valkey 7.0 and above.
+func (rules *AclRules) AddSelector(selector *AclRules) *AclRules {
+	if selector == nil {
+		return rules.fail(errors.New("the selector is nil"))
+	}
+	if selector.err != nil {
+		return rules.fail(selector.err)
+	}
+	for _, rule := range selector.rules {
+		if !isSelectorRule(rule) {
+			return rules.fail(errors.New("rule '" + rule + "' can't be used in a selector"))
+		}
+	}
+	return rules.add("(" + strings.Join(selector.rules, " ") + ")")
+}
+
+// ClearSelectors removes the selectors of the user.
+//
+// Since Valkey 7.0 and above.
+func (rules *AclRules) ClearSelectors() *AclRules {
+	return rules.add("clearselectors")
+}
+
+// Reset resets the user to its initial state: disabled, without passwords, keys, channels, commands and selectors.
+func (rules *AclRules) Reset() *AclRules {
+	return rules.add("reset")
+}
+
+// ToArgs returns the rules as arguments of `ACL SETUSER`, or the first error found while building them.
+func (rules *AclRules) ToArgs() ([]string, error) {
+	if rules == nil {
+		return []string{}, nil
+	}
+	if rules.err != nil {
+		return nil, rules.err
+	}
+	return append([]string{}, rules.rules...), nil
+}
+
+// Optional arguments to `AclLog` for standalone client
+type AclLogOptions struct {
+	// The maximum number of entries to return. The server returns 10 entries when it isn't set.
+	Count int64
+}
+
+// Optional arguments to `AclLog` for cluster client
+type ClusterAclLogOptions struct {
+	*AclLogOptions
+	// The nodes to get the entries from. The entries are returned from all nodes when it isn't set.
+	*RouteOption
+}
+
+// NewAclLogOptions creates a new AclLogOptions returning up to count entries.
+func NewAclLogOptions(count int64) *AclLogOptions {
+	return &AclLogOptions{Count: count}
+}
+
+func (options *AclLogOptions) ToArgs() ([]string, error) {
+	if options == nil {
+		return []string{}, nil
+	}
+	return []string{utils.IntToString(options.Count)}, nil
+}
+
+func checkPasswordHash(hash string) error {
+	if decoded, err := hex.DecodeString(hash); err != nil || len(decoded) != 32 {
+		return errors.New("the password hash must be a hex encoded SHA-256 hash of 64 characters")
+	}
+	return nil
+}
+
+func isSelectorRule(rule string) bool {
+	switch rule {
+	case "allkeys", "resetkeys", "allchannels", "resetchannels", "allcommands", "nocommands":
+		return true
+	}
+	return strings.HasPrefix(rule, "~") || strings.HasPrefix(rule, "%") || strings.HasPrefix(rule, "&") ||
+		strings.HasPrefix(rule, "+") || strings.HasPrefix(rule, "-")
+}
@@ -0,0 +1,104 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/utils"
+)
+
+// ClientPauseMode defines the commands paused by `ClientPause`.
+type ClientPauseMode string
+
+const (
+	// PauseWrite pauses the commands which may write, letting the read commands run.
+	PauseWrite ClientPauseMode = "WRITE"
+	// PauseAll pauses all the commands.
+	PauseAll ClientPauseMode = "ALL"
+)
+
+// ClientInfoAttribute is an attribute of a connection set by `ClientSetInfo`.
+type ClientInfoAttribute string
+
+const (
+	// ClientLibName is the name of the client library.
+	ClientLibName ClientInfoAttribute = "LIB-NAME"
+	// ClientLibVersion is the version of the client library.
+	ClientLibVersion ClientInfoAttribute = "LIB-VER"
+)
+
+// Filters of `ClientKill`. The connections matching all the filters are killed.
+type ClientKillOptions struct {
+	id     *int64
+	addr   string
+	user   string
+	maxAge *int64
+	skipMe *bool
+}
+
+func NewClientKillOptions() *ClientKillOptions {
+	return &ClientKillOptions{}
+}
+
+// SetId kills the connection with the given id, as returned by `ClientId`.
+func (options *ClientKillOptions) SetId(id int64) *ClientKillOptions {
+	options.id = &id
+	return options
+}
+
+// SetAddr kills the connection from the given address, in the format "ip:port".
+func (options *ClientKillOptions) SetAddr(addr string) *ClientKillOptions {
+	options.addr = addr
+	return options
+}
+
+// SetUser kills the connections authenticated as the given user.
+func (options *ClientKillOptions) SetUser(user string) *ClientKillOptions {
+	options.user = user
+	return options
+}
+
+// SetMaxAge kills the connections older than the given number of seconds.
+//
+// Since Valkey 8.0 and above.
+func (options *ClientKillOptions) SetMaxAge(seconds int64) *ClientKillOptions {
+	options.maxAge = &seconds
+	return options
+}
+
+// SetSkipMe sets whether the connection sending the command is spared, which it is by default.
+func (options *ClientKillOptions) SetSkipMe(skipMe bool) *ClientKillOptions {
+	options.skipMe = &skipMe
+	return options
+}
+
+func (options *ClientKillOptions) ToArgs() ([]string, error) {
+	if options == nil {
+		return nil, errors.New("at least one filter must be set")
+	}
+	args := []string{}
+	if options.id != nil {
+		args = append(args, "ID", utils.IntToString(*options.id))
+	}
+	if options.addr != "" {
+		args = append(args, "ADDR", options.addr)
+	}
+	if options.user != "" {
+		args = append(args, "USER", options.user)
+	}
+	if options.maxAge != nil {
+		args = append(args, "MAXAGE", utils.IntToString(*options.maxAge))
+	}
+	if options.skipMe != nil {
+		if *options.skipMe {
+			args = append(args, "SKIPME", "YES")
+		} else {
+			args = append(args, "SKIPME", "NO")
+		}
+	}
+	if len(args) == 0 {
+		return nil, errors.New("at least one filter must be set")
+	}
+	return args, nil
+}
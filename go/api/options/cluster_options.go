@@ -0,0 +1,72 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"errors"
+
+	"github.com/valkey-io/valkey-glide/go/utils"
+)
+
+// SlotStatsMetric is a statistic of a slot by which `ClusterSlotStats` can order the slots.
+type SlotStatsMetric string
+
+const (
+	// SlotStatsKeyCount is the number of keys of the slot.
+	SlotStatsKeyCount SlotStatsMetric = "KEY-COUNT"
+	// SlotStatsCpuUsec is the CPU time spent on the slot, in microseconds.
+	SlotStatsCpuUsec SlotStatsMetric = "CPU-USEC"
+	// SlotStatsNetworkBytesIn is the number of bytes received for the slot.
+	SlotStatsNetworkBytesIn SlotStatsMetric = "NETWORK-BYTES-IN"
+	// SlotStatsNetworkBytesOut is the number of bytes sent for the slot.
+	SlotStatsNetworkBytesOut SlotStatsMetric = "NETWORK-BYTES-OUT"
+)
+
+// Selects the slots returned by `ClusterSlotStats`, either a range of slots or the slots ordered by a statistic.
+type SlotStatsOptions struct {
+	startSlot int64
+	endSlot   int64
+	metric    SlotStatsMetric
+	limit     int64
+	order     OrderBy
+}
+
+// NewSlotStatsRangeOptions selects the slots from startSlot to endSlot, both included.
+func NewSlotStatsRangeOptions(startSlot int64, endSlot int64) *SlotStatsOptions {
+	return &SlotStatsOptions{startSlot: startSlot, endSlot: endSlot}
+}
+
+// NewSlotStatsOrderByOptions selects the slots ordered by metric, in descending order unless set otherwise.
+func NewSlotStatsOrderByOptions(metric SlotStatsMetric) *SlotStatsOptions {
+	return &SlotStatsOptions{metric: metric}
+}
+
+// SetLimit sets the maximum number of slots returned when they are ordered by a statistic. The server returns 16 slots
+// when it isn't set.
+func (options *SlotStatsOptions) SetLimit(limit int64) *SlotStatsOptions {
+	options.limit = limit
+	return options
+}
+
+// SetOrder sets the order of the slots when they are ordered by a statistic.
+func (options *SlotStatsOptions) SetOrder(order OrderBy) *SlotStatsOptions {
+	options.order = order
+	return options
+}
+
+func (options *SlotStatsOptions) ToArgs() ([]string, error) {
+	if options == nil {
+		return nil, errors.New("either a range of slots or a statistic to order by must be set")
+	}
+	if options.metric == "" {
+		return []string{"SLOTSRANGE", utils.IntToString(options.startSlot), utils.IntToString(options.endSlot)}, nil
+	}
+	args := []string{"ORDERBY", string(options.metric)}
+	if options.limit != 0 {
+		args = append(args, "LIMIT", utils.IntToString(options.limit))
+	}
+	if options.order != "" {
+		args = append(args, string(options.order))
+	}
+	return args, nil
+}
@@ -39,6 +39,7 @@ const (
 	StreamsKeyword      string = "STREAMS"
 	WithCodeKeyword     string = "WITHCODE"
 	LibraryNameKeyword  string = "LIBRARYNAME"
+	ResetKeyword        string = "RESET" // Valkey API keyword to clear the entries of `ACL LOG`.
 )
 
 type InfBoundary string
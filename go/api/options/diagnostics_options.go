@@ -0,0 +1,51 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package options
+
+import (
+	"github.com/valkey-io/valkey-glide/go/utils"
+)
+
+// Optional arguments to `SlowLogGet` for standalone client
+type SlowLogOptions struct {
+	// The maximum number of entries to return, or -1 for all the entries. The server returns 10 entries when it isn't set.
+	Count int64
+}
+
+// Optional arguments to `SlowLogGet` for cluster client
+type ClusterSlowLogOptions struct {
+	*SlowLogOptions
+	// The nodes to get the entries from. The entries are returned from all nodes when it isn't set.
+	*RouteOption
+}
+
+// NewSlowLogOptions creates a new SlowLogOptions returning up to count entries.
+func NewSlowLogOptions(count int64) *SlowLogOptions {
+	return &SlowLogOptions{Count: count}
+}
+
+func (options *SlowLogOptions) ToArgs() ([]string, error) {
+	if options == nil {
+		return []string{}, nil
+	}
+	return []string{utils.IntToString(options.Count)}, nil
+}
+
+// Optional arguments to `MemoryUsage`
+type MemoryUsageOptions struct {
+	// The number of nested values sampled to estimate the memory usage of collections, or 0 for all of them. The server
+	// samples 5 values when it isn't set.
+	Samples int64
+}
+
+// NewMemoryUsageOptions creates a new MemoryUsageOptions sampling the given number of nested values.
+func NewMemoryUsageOptions(samples int64) *MemoryUsageOptions {
+	return &MemoryUsageOptions{Samples: samples}
+}
+
+func (options *MemoryUsageOptions) ToArgs() ([]string, error) {
+	if options == nil {
+		return []string{}, nil
+	}
+	return []string{"SAMPLES", utils.IntToString(options.Samples)}, nil
+}
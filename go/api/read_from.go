@@ -0,0 +1,243 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+// nodeZoneFetchTimeout bounds the time spent fetching the availability zone of a node.
+const nodeZoneFetchTimeout = 5 * time.Second
+
+type readFromContextKey struct{}
+
+// WithReadFrom returns a copy of ctx that overrides the client's [ReadFrom] strategy for the commands executed with it.
+//
+// In cluster mode, the override applies to the read-only commands routed by their key, and to the commands sent with a
+// [config.SlotKeyRoute] or a [config.SlotIdRoute], such as with [GlideClusterClient.CustomCommandWithRoute], whose slot
+// type is replaced. Other commands and routes are not affected. [AzAffinity] and [AzAffinityReplicaAndPrimary] require the
+// client AZ to be configured, and [LowestLatency] requires the client to be configured with the [LowestLatency] strategy.
+// Replicas only serve the reads of cluster clients configured with a strategy other than [Primary], so such clients fail
+// the commands executed with an override other than [Primary].
+//
+// In standalone mode, the override applies to the read-only commands, which are served by a separate client configured
+// with the override and connected on first use. [LowestLatency] isn't supported in standalone mode.
+//
+// For example:
+//
+//	// Read our own write from the primary, even though the client reads from replicas.
+//	_, err := client.Set(ctx, "key", "value")
+//	value, err := client.Get(api.WithReadFrom(ctx, api.Primary), "key")
+func WithReadFrom(ctx context.Context, readFrom ReadFrom) context.Context {
+	return context.WithValue(ctx, readFromContextKey{}, readFrom)
+}
+
+// readFromRoute returns the route of a command, taking the [ReadFrom] override of ctx into account.
+func (client *baseClient) readFromRoute(
+	ctx context.Context,
+	requestType protobuf.RequestType,
+	args []string,
+	route config.Route,
+) (config.Route, error) {
+	readFrom, ok := ctx.Value(readFromContextKey{}).(ReadFrom)
+	if !ok || (route == nil && readFrom == client.readFrom) {
+		if client.latencies != nil && route == nil {
+			return client.lowestLatencyRoute(requestType, args), nil
+		}
+		return route, nil
+	}
+	// Only cluster clients cache the slot map of the server. The overrides of standalone clients are served by
+	// readFromOverrideClient.
+	if client.clusterSlots == nil {
+		return route, nil
+	}
+
+	var slot int
+	var slotRoute func(slotType config.SlotType) config.Route
+	switch route := route.(type) {
+	case nil:
+		if len(args) == 0 {
+			return nil, nil
+		}
+		if _, ok := idempotentRequestTypes[requestType]; !ok {
+			return nil, nil
+		}
+		if _, ok := firstKeyRequestTypes[requestType]; !ok {
+			return nil, nil
+		}
+		key := args[0]
+		slot = keyHashSlot(key)
+		slotRoute = func(slotType config.SlotType) config.Route { return config.NewSlotKeyRoute(slotType, key) }
+	case *config.SlotKeyRoute:
+		slot = keyHashSlot(route.SlotKey)
+		slotRoute = func(slotType config.SlotType) config.Route { return config.NewSlotKeyRoute(slotType, route.SlotKey) }
+	case *config.SlotIdRoute:
+		slot = int(route.SlotID)
+		slotRoute = func(slotType config.SlotType) config.Route { return config.NewSlotIdRoute(slotType, route.SlotID) }
+	default:
+		return route, nil
+	}
+
+	if readFrom != Primary && client.readFrom == Primary {
+		// The core only sends READONLY to the replicas when the client reads from them, otherwise they redirect the
+		// commands to the primary.
+		return nil, &errors.RequestError{
+			Msg: "ReadFrom override reading from replicas requires the client to be configured with a ReadFrom strategy " +
+				"other than Primary",
+		}
+	}
+	switch readFrom {
+	case Primary:
+		return slotRoute(config.SlotTypePrimary), nil
+	case PreferReplica:
+		return slotRoute(config.SlotTypeReplica), nil
+	case AzAffinity, AzAffinityReplicaAndPrimary:
+		if client.clientAZ == "" {
+			return nil, &errors.RequestError{Msg: "ReadFrom override with AZ affinity requires the client AZ to be configured"}
+		}
+		if zoneRoute := client.zoneLocalRoute(slot, readFrom == AzAffinityReplicaAndPrimary); zoneRoute != nil {
+			return zoneRoute, nil
+		}
+		return slotRoute(config.SlotTypeReplica), nil
+	case LowestLatency:
+		if client.latencies == nil {
+			return nil, &errors.RequestError{
+				Msg: "ReadFrom override with LowestLatency requires the client to be configured with LowestLatency",
+			}
+		}
+		if latencyRoute := client.lowestLatencyReplicaRoute(slot); latencyRoute != nil {
+			return latencyRoute, nil
+		}
+		return slotRoute(config.SlotTypeReplica), nil
+	}
+	return nil, &errors.RequestError{Msg: fmt.Sprintf("Invalid ReadFrom override %d", readFrom)}
+}
+
+// readFromOverrideClient returns the client serving a read-only command of a standalone client executed with a [ReadFrom]
+// override, which is connected on first use. It returns nil when the command is served by the client itself.
+func (client *baseClient) readFromOverrideClient(ctx context.Context, requestType protobuf.RequestType) (*baseClient, error) {
+	readFrom, ok := ctx.Value(readFromContextKey{}).(ReadFrom)
+	if !ok || readFrom == client.readFrom || client.readFromConfig == nil {
+		return nil, nil
+	}
+	if _, ok := idempotentRequestTypes[requestType]; !ok {
+		return nil, nil
+	}
+	switch readFrom {
+	case Primary, PreferReplica:
+	case AzAffinity, AzAffinityReplicaAndPrimary:
+		if client.clientAZ == "" {
+			return nil, &errors.RequestError{Msg: "ReadFrom override with AZ affinity requires the client AZ to be configured"}
+		}
+	case LowestLatency:
+		return nil, &errors.RequestError{Msg: "ReadFrom override with LowestLatency is only supported in cluster mode"}
+	default:
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("Invalid ReadFrom override %d", readFrom)}
+	}
+
+	client.readFromMu.Lock()
+	defer client.readFromMu.Unlock()
+	select {
+	case <-client.closed:
+		return nil, &errors.ClosingError{Msg: "The client is closed."}
+	default:
+	}
+	if readFromClient, ok := client.readFromClients[readFrom]; ok {
+		return readFromClient, nil
+	}
+	readFromClient, err := createClient(client.readFromConfig(readFrom))
+	if err != nil {
+		return nil, err
+	}
+	readFromClient.readFrom = readFrom
+	readFromClient.clientAZ = client.clientAZ
+	if client.readFromClients == nil {
+		client.readFromClients = make(map[ReadFrom]*baseClient)
+	}
+	client.readFromClients[readFrom] = readFromClient
+	return readFromClient, nil
+}
+
+// zoneLocalRoute returns a route to one of the replicas serving slot in the client's AZ, spreading the requests in a
+// round-robin manner. When there is no such replica and includePrimary is set, the route leads to the primary if it is in
+// the client's AZ. Returns nil if no node matches.
+func (client *baseClient) zoneLocalRoute(slot int, includePrimary bool) config.Route {
+	shard := client.clusterSlots.shard(slot)
+	if shard == nil {
+		return nil
+	}
+	nodes := client.nodeZones.inZone(shard.replicas, client.clientAZ)
+	if len(nodes) == 0 && includePrimary {
+		nodes = client.nodeZones.inZone([]string{shard.primary}, client.clientAZ)
+	}
+	if len(nodes) == 0 {
+		return nil
+	}
+	if route, ok := nodeRoute(client.nodeZones.pick(nodes)); ok {
+		return route
+	}
+	return nil
+}
+
+// nodeZones caches the availability zone of the nodes of a cluster. The zone of a node is fetched in the background the
+// first time the node is looked up, and the node is considered outside of every zone until then.
+type nodeZones struct {
+	mu       sync.Mutex
+	zones    map[string]string
+	fetching map[string]struct{}
+	fetch    func(ctx context.Context, node string) (string, error)
+	next     atomic.Uint64
+}
+
+func newNodeZones(fetch func(ctx context.Context, node string) (string, error)) *nodeZones {
+	return &nodeZones{zones: make(map[string]string), fetching: make(map[string]struct{}), fetch: fetch}
+}
+
+// inZone returns the nodes among nodes which are known to be in zone.
+func (cache *nodeZones) inZone(nodes []string, zone string) []string {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	var local []string
+	for _, node := range nodes {
+		nodeZone, ok := cache.zones[node]
+		if !ok {
+			cache.requestFetch(node)
+		} else if nodeZone == zone {
+			local = append(local, node)
+		}
+	}
+	return local
+}
+
+// requestFetch fetches the zone of node in the background, unless it is already being fetched. Must be called with mu held.
+func (cache *nodeZones) requestFetch(node string) {
+	if _, ok := cache.fetching[node]; ok {
+		return
+	}
+	cache.fetching[node] = struct{}{}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), nodeZoneFetchTimeout)
+		defer cancel()
+		zone, err := cache.fetch(ctx, node)
+		cache.mu.Lock()
+		defer cache.mu.Unlock()
+		delete(cache.fetching, node)
+		// Failed fetches are retried on the next lookup of the node.
+		if err == nil {
+			cache.zones[node] = zone
+		}
+	}()
+}
+
+// pick returns one of nodes, in a round-robin manner.
+func (cache *nodeZones) pick(nodes []string) string {
+	return nodes[cache.next.Add(1)%uint64(len(nodes))]
+}
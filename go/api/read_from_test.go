@@ -0,0 +1,138 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+func newTestReadFromClient(readFrom ReadFrom) *baseClient {
+	zones := map[string]string{
+		"10.0.0.1:6379": "az-1",
+		"10.0.0.2:6379": "az-2",
+		"10.0.0.3:6379": "az-2",
+		"10.0.0.4:6379": "az-1",
+	}
+	return &baseClient{
+		readFrom:     readFrom,
+		clientAZ:     "az-1",
+		clusterSlots: newTestClusterSlots(newFakeClock()),
+		nodeZones: newNodeZones(func(ctx context.Context, node string) (string, error) {
+			return zones[node], nil
+		}),
+	}
+}
+
+func TestReadFromRoute_KeyedCommands(t *testing.T) {
+	client := newTestReadFromClient(PreferReplica)
+	get := func(ctx context.Context) config.Route {
+		route, err := client.readFromRoute(ctx, protobuf.RequestType_Get, []string{"user1000"}, nil)
+		assert.NoError(t, err)
+		return route
+	}
+
+	assert.Nil(t, get(context.Background()))
+	assert.Nil(t, get(WithReadFrom(context.Background(), PreferReplica)))
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypePrimary, "user1000"), get(WithReadFrom(context.Background(), Primary)))
+
+	// Writes and keyless commands are routed by the core.
+	ctx := WithReadFrom(context.Background(), Primary)
+	route, err := client.readFromRoute(ctx, protobuf.RequestType_Set, []string{"user1000", "value"}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, route)
+	route, err = client.readFromRoute(ctx, protobuf.RequestType_Ping, nil, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, route)
+}
+
+func TestReadFromRoute_SlotRoutes(t *testing.T) {
+	client := newTestReadFromClient(AzAffinity)
+
+	ctx := WithReadFrom(context.Background(), PreferReplica)
+	route, err := client.readFromRoute(ctx, protobuf.RequestType_CustomCommand, []string{"GET", "user1000"},
+		config.NewSlotKeyRoute(config.SlotTypePrimary, "user1000"))
+	assert.NoError(t, err)
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypeReplica, "user1000"), route)
+
+	ctx = WithReadFrom(context.Background(), Primary)
+	route, err = client.readFromRoute(ctx, protobuf.RequestType_CustomCommand, []string{"ROLE"},
+		config.NewSlotIdRoute(config.SlotTypeReplica, 42))
+	assert.NoError(t, err)
+	assert.Equal(t, config.NewSlotIdRoute(config.SlotTypePrimary, 42), route)
+
+	// Routes which don't target a slot are left untouched.
+	route, err = client.readFromRoute(ctx, protobuf.RequestType_CustomCommand, []string{"ROLE"}, config.AllNodes)
+	assert.NoError(t, err)
+	assert.Equal(t, config.AllNodes, route)
+
+	// The replicas don't serve the reads of a client configured to read from the primary.
+	client = newTestReadFromClient(Primary)
+	_, err = client.readFromRoute(WithReadFrom(context.Background(), PreferReplica), protobuf.RequestType_CustomCommand,
+		[]string{"GET", "user1000"}, config.NewSlotKeyRoute(config.SlotTypePrimary, "user1000"))
+	assert.ErrorAs(t, err, new(*errors.RequestError))
+	_, err = client.readFromRoute(WithReadFrom(context.Background(), AzAffinity), protobuf.RequestType_Get,
+		[]string{"user1000"}, nil)
+	assert.ErrorAs(t, err, new(*errors.RequestError))
+	route, err = client.readFromRoute(WithReadFrom(context.Background(), PreferReplica), protobuf.RequestType_Set,
+		[]string{"user1000", "value"}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, route)
+}
+
+func TestReadFromRoute_AzAffinity(t *testing.T) {
+	client := newTestReadFromClient(PreferReplica)
+	ctx := WithReadFrom(context.Background(), AzAffinity)
+	get := func(key string) config.Route {
+		route, err := client.readFromRoute(ctx, protobuf.RequestType_Get, []string{key}, nil)
+		assert.NoError(t, err)
+		return route
+	}
+
+	// Zones are unknown until fetched, in which case any replica is used.
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypeReplica, "user1000"), get("user1000"))
+	assert.Eventually(t, func() bool {
+		_, ok := get("user1000").(*config.ByAddressRoute)
+		return ok
+	}, time.Second, time.Millisecond)
+	// "user1000" is served by the first shard, whose replica 10.0.0.4 is in the client's AZ.
+	assert.Equal(t, config.NewByAddressRoute("10.0.0.4", 6379), get("user1000"))
+	assert.Equal(t, config.NewByAddressRoute("10.0.0.4", 6379), get("user1000"))
+
+	// "foo" is served by the second shard, without replica in the client's AZ.
+	assert.Equal(t, config.NewSlotKeyRoute(config.SlotTypeReplica, "foo"), get("foo"))
+
+	client.clientAZ = ""
+	_, err := client.readFromRoute(ctx, protobuf.RequestType_Get, []string{"user1000"}, nil)
+	assert.ErrorAs(t, err, new(*errors.RequestError))
+}
+
+func TestReadFromRoute_Standalone(t *testing.T) {
+	client := &baseClient{readFrom: PreferReplica}
+	client.readFromConfig = func(readFrom ReadFrom) clientConfiguration {
+		t.Fatal("no client should be created")
+		return nil
+	}
+
+	// Standalone clients serve the overrides with a separate client, and leave the route untouched.
+	ctx := WithReadFrom(context.Background(), Primary)
+	route, err := client.readFromRoute(ctx, protobuf.RequestType_Get, []string{"key"}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, route)
+
+	override := func(readFrom ReadFrom, requestType protobuf.RequestType) error {
+		readFromClient, err := client.readFromOverrideClient(WithReadFrom(context.Background(), readFrom), requestType)
+		assert.Nil(t, readFromClient)
+		return err
+	}
+	assert.NoError(t, override(PreferReplica, protobuf.RequestType_Get))
+	assert.NoError(t, override(Primary, protobuf.RequestType_Set))
+	assert.ErrorAs(t, override(LowestLatency, protobuf.RequestType_Get), new(*errors.RequestError))
+	assert.ErrorAs(t, override(AzAffinity, protobuf.RequestType_Get), new(*errors.RequestError))
+}
@@ -10,9 +10,11 @@ import (
 	"reflect"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 	"unsafe"
 
+	"github.com/valkey-io/valkey-glide/go/api/config"
 	"github.com/valkey-io/valkey-glide/go/api/errors"
 	"github.com/valkey-io/valkey-glide/go/api/options"
 )
@@ -57,10 +59,50 @@ func convertCharArrayToString(response *C.struct_CommandResponse, isNilable bool
 	if response.string_value == nil {
 		return CreateNilStringResult(), nil
 	}
-	byteSlice := C.GoBytes(unsafe.Pointer(response.string_value), C.int(int64(response.string_value_len)))
+	// Copied once, from the memory of the core to the string (preserving null characters)
+	return CreateStringResult(string(cStringBytes(response))), nil
+}
+
+// cStringBytes returns the bytes of the string value of response, in the memory of the core, without copying them. The
+// bytes are only valid until the response is freed.
+func cStringBytes(response *C.struct_CommandResponse) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(response.string_value)), response.string_value_len)
+}
+
+// stringBuffer copies the string values of the elements of a response into a single allocation, and returns them as
+// strings sharing it, so that decoding an array or a map of strings allocates once for all its strings. The sizes of the
+// string values are first added with reserve, then the strings are copied with convert.
+type stringBuffer struct {
+	size int
+	buf  []byte
+}
+
+// reserve adds the size of the string value of response to the buffer.
+func (buffer *stringBuffer) reserve(response *C.struct_CommandResponse) {
+	if response != nil && response.string_value != nil {
+		buffer.size += int(response.string_value_len)
+	}
+}
+
+// convert is the counterpart of convertCharArrayToString copying the string into the buffer.
+func (buffer *stringBuffer) convert(response *C.struct_CommandResponse, isNilable bool) (Result[string], error) {
+	typeErr := checkResponseType(response, C.String, isNilable)
+	if typeErr != nil {
+		return CreateNilStringResult(), typeErr
+	}
 
-	// Create Go string from byte slice (preserving null characters)
-	return CreateStringResult(string(byteSlice)), nil
+	if response.string_value == nil {
+		return CreateNilStringResult(), nil
+	}
+	if buffer.buf == nil {
+		buffer.buf = make([]byte, 0, buffer.size)
+	}
+	start := len(buffer.buf)
+	// When the reserved size is exceeded, the strings already returned keep pointing to the previous allocation, which is
+	// never written again.
+	buffer.buf = append(buffer.buf, cStringBytes(response)...)
+	value := buffer.buf[start:]
+	return CreateStringResult(unsafe.String(unsafe.SliceData(value), len(value))), nil
 }
 
 func handleInterfaceResponse(response *C.struct_CommandResponse) (interface{}, error) {
@@ -102,10 +144,8 @@ func parseString(response *C.struct_CommandResponse) (interface{}, error) {
 	if response.string_value == nil {
 		return nil, nil
 	}
-	byteSlice := C.GoBytes(unsafe.Pointer(response.string_value), C.int(int64(response.string_value_len)))
-
-	// Create Go string from byte slice (preserving null characters)
-	return string(byteSlice), nil
+	// Copied once, from the memory of the core to the string (preserving null characters)
+	return string(cStringBytes(response)), nil
 }
 
 func parseArray(response *C.struct_CommandResponse) (interface{}, error) {
@@ -131,7 +171,7 @@ func parseMap(response *C.struct_CommandResponse) (interface{}, error) {
 
 	value_map := make(map[string]interface{}, response.array_value_len)
 	for _, v := range unsafe.Slice(response.array_value, response.array_value_len) {
-		res_key, err := parseString(v.map_key)
+		res_key, err := parseInterface(v.map_key)
 		if err != nil {
 			return nil, err
 		}
@@ -139,7 +179,12 @@ func parseMap(response *C.struct_CommandResponse) (interface{}, error) {
 		if err != nil {
 			return nil, err
 		}
-		value_map[res_key.(string)] = res_val
+		// Some maps are keyed by numbers, such as the buckets of LATENCY HISTOGRAM.
+		key, ok := res_key.(string)
+		if !ok {
+			key = fmt.Sprint(res_key)
+		}
+		value_map[key] = res_val
 	}
 	return value_map, nil
 }
@@ -202,9 +247,14 @@ func convertStringOrNilArray(response *C.struct_CommandResponse) ([]Result[strin
 		return nil, typeErr
 	}
 
-	slice := make([]Result[string], 0, response.array_value_len)
-	for _, v := range unsafe.Slice(response.array_value, response.array_value_len) {
-		res, err := convertCharArrayToString(&v, true)
+	elements := unsafe.Slice(response.array_value, response.array_value_len)
+	var buffer stringBuffer
+	for i := range elements {
+		buffer.reserve(&elements[i])
+	}
+	slice := make([]Result[string], 0, len(elements))
+	for i := range elements {
+		res, err := buffer.convert(&elements[i], true)
 		if err != nil {
 			return nil, err
 		}
@@ -301,9 +351,14 @@ func convertStringArray(response *C.struct_CommandResponse, isNilable bool) ([]s
 		return nil, nil
 	}
 
-	slice := make([]string, 0, response.array_value_len)
-	for _, v := range unsafe.Slice(response.array_value, response.array_value_len) {
-		res, err := convertCharArrayToString(&v, false)
+	elements := unsafe.Slice(response.array_value, response.array_value_len)
+	var buffer stringBuffer
+	for i := range elements {
+		buffer.reserve(&elements[i])
+	}
+	slice := make([]string, 0, len(elements))
+	for i := range elements {
+		res, err := buffer.convert(&elements[i], false)
 		if err != nil {
 			return nil, err
 		}
@@ -619,21 +674,23 @@ func handleStringToStringMapResponse(response *C.struct_CommandResponse) (map[st
 		return nil, typeErr
 	}
 
-	data, err := parseMap(response)
-	if err != nil {
-		return nil, err
+	entries := unsafe.Slice(response.array_value, response.array_value_len)
+	var buffer stringBuffer
+	for i := range entries {
+		buffer.reserve(entries[i].map_key)
+		buffer.reserve(entries[i].map_value)
 	}
-	aMap := data.(map[string]interface{})
-
-	converted, err := mapConverter[string]{
-		nil, false,
-	}.convert(aMap)
-	if err != nil {
-		return nil, err
-	}
-	result, ok := converted.(map[string]string)
-	if !ok {
-		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type of map: %T", converted)}
+	result := make(map[string]string, len(entries))
+	for i := range entries {
+		key, err := buffer.convert(entries[i].map_key, false)
+		if err != nil {
+			return nil, err
+		}
+		value, err := buffer.convert(entries[i].map_value, false)
+		if err != nil {
+			return nil, err
+		}
+		result[key.Value()] = value.Value()
 	}
 	return result, nil
 }
@@ -681,9 +738,14 @@ func handleStringSetResponse(response *C.struct_CommandResponse) (map[string]str
 		return nil, typeErr
 	}
 
-	slice := make(map[string]struct{}, response.sets_value_len)
-	for _, v := range unsafe.Slice(response.sets_value, response.sets_value_len) {
-		res, err := convertCharArrayToString(&v, true)
+	members := unsafe.Slice(response.sets_value, response.sets_value_len)
+	var buffer stringBuffer
+	for i := range members {
+		buffer.reserve(&members[i])
+	}
+	slice := make(map[string]struct{}, len(members))
+	for i := range members {
+		res, err := buffer.convert(&members[i], true)
 		if err != nil {
 			return nil, err
 		}
@@ -1702,3 +1764,667 @@ func handleSortedSetWithScoresResponse(response *C.struct_CommandResponse, rever
 
 	return zRangeResponseArray, nil
 }
+
+// handleClusterValueResponse converts the response of a command routed by route, converting the value of every node with
+// convert when the route is a multi-node route.
+func handleClusterValueResponse[T any](
+	response *C.struct_CommandResponse,
+	route config.Route,
+	convert func(interface{}) (T, error),
+) (ClusterValue[T], error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return createEmptyClusterValue[T](), err
+	}
+	if route == nil || !route.IsMultiNode() {
+		value, err := convert(data)
+		if err != nil {
+			return createEmptyClusterValue[T](), err
+		}
+		return createClusterSingleValue(value), nil
+	}
+	nodes, ok := data.(map[string]interface{})
+	if !ok {
+		return createEmptyClusterValue[T](), &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T", data)}
+	}
+	values := make(map[string]T, len(nodes))
+	for node, nodeData := range nodes {
+		if values[node], err = convert(nodeData); err != nil {
+			return createEmptyClusterValue[T](), err
+		}
+	}
+	return createClusterMultiValue(values), nil
+}
+
+// handleAllNodesOkResponse checks that every node replied OK to a command sent to multiple nodes.
+func handleAllNodesOkResponse(response *C.struct_CommandResponse) (string, error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	nodes, ok := data.(map[string]interface{})
+	if !ok {
+		return convertOkValue(data)
+	}
+	for _, nodeData := range nodes {
+		if _, err := convertOkValue(nodeData); err != nil {
+			return DefaultStringResponse, err
+		}
+	}
+	return OK, nil
+}
+
+func handleOkOrStringResponse(response *C.struct_CommandResponse) (string, error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return convertStringValue(data)
+}
+
+func handleAclUserResponse(response *C.struct_CommandResponse) (Result[AclUser], error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return CreateNilAclUserResult(), err
+	}
+	return convertAclUser(data)
+}
+
+func handleAclLogResponse(response *C.struct_CommandResponse) ([]AclLogEntry, error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	return convertAclLog(data)
+}
+
+func convertOkValue(data interface{}) (string, error) {
+	if data != OK {
+		return DefaultStringResponse, &errors.RequestError{Msg: fmt.Sprintf("unexpected response: %v, expected: OK", data)}
+	}
+	return OK, nil
+}
+
+func convertStringValue(data interface{}) (string, error) {
+	value, ok := data.(string)
+	if !ok {
+		return DefaultStringResponse, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: string", data)}
+	}
+	return value, nil
+}
+
+func convertStringArrayValue(data interface{}) ([]string, error) {
+	if data == nil {
+		return []string{}, nil
+	}
+	array, ok := data.([]interface{})
+	if !ok {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: array", data)}
+	}
+	return convertToStringArray(array)
+}
+
+func convertAclUser(data interface{}) (Result[AclUser], error) {
+	if data == nil {
+		return CreateNilAclUserResult(), nil
+	}
+	fields, ok := data.(map[string]interface{})
+	if !ok {
+		return CreateNilAclUserResult(), &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: map", data)}
+	}
+	flags, err := convertStringArrayValue(fields["flags"])
+	if err != nil {
+		return CreateNilAclUserResult(), err
+	}
+	passwords, err := convertStringArrayValue(fields["passwords"])
+	if err != nil {
+		return CreateNilAclUserResult(), err
+	}
+	user := AclUser{
+		Flags:     flags,
+		Passwords: passwords,
+		Commands:  stringField(fields, "commands"),
+		Keys:      stringField(fields, "keys"),
+		Channels:  stringField(fields, "channels"),
+		Selectors: []AclSelector{},
+	}
+	selectors, _ := fields["selectors"].([]interface{})
+	for _, selector := range selectors {
+		selectorFields, ok := selector.(map[string]interface{})
+		if !ok {
+			return CreateNilAclUserResult(), &errors.RequestError{Msg: fmt.Sprintf("unexpected type of selector: %T", selector)}
+		}
+		user.Selectors = append(user.Selectors, AclSelector{
+			Commands: stringField(selectorFields, "commands"),
+			Keys:     stringField(selectorFields, "keys"),
+			Channels: stringField(selectorFields, "channels"),
+		})
+	}
+	return CreateAclUserResult(user), nil
+}
+
+func convertAclLog(data interface{}) ([]AclLogEntry, error) {
+	if data == nil {
+		return []AclLogEntry{}, nil
+	}
+	array, ok := data.([]interface{})
+	if !ok {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: array", data)}
+	}
+	entries := make([]AclLogEntry, 0, len(array))
+	for _, item := range array {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type of entry: %T", item)}
+		}
+		entry := AclLogEntry{
+			Reason:               stringField(fields, "reason"),
+			Context:              stringField(fields, "context"),
+			Object:               stringField(fields, "object"),
+			Username:             stringField(fields, "username"),
+			ClientInfo:           stringField(fields, "client-info"),
+			EntryId:              CreateNilInt64Result(),
+			TimestampCreated:     CreateNilInt64Result(),
+			TimestampLastUpdated: CreateNilInt64Result(),
+		}
+		entry.Count, _ = fields["count"].(int64)
+		switch age := fields["age-seconds"].(type) {
+		case float64:
+			entry.AgeSeconds = age
+		case string:
+			var err error
+			if entry.AgeSeconds, err = strconv.ParseFloat(age, 64); err != nil {
+				return nil, &errors.RequestError{Msg: "unexpected age of entry: " + age}
+			}
+		}
+		if id, ok := fields["entry-id"].(int64); ok {
+			entry.EntryId = CreateInt64Result(id)
+		}
+		if created, ok := fields["timestamp-created"].(int64); ok {
+			entry.TimestampCreated = CreateInt64Result(created)
+		}
+		if updated, ok := fields["timestamp-last-updated"].(int64); ok {
+			entry.TimestampLastUpdated = CreateInt64Result(updated)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// stringField returns the string value of a field of a map reply, or an empty string when it is missing.
+func stringField(fields map[string]interface{}, name string) string {
+	value, _ := fields[name].(string)
+	return value
+}
+
+func convertIntValue(data interface{}) (int64, error) {
+	value, ok := data.(int64)
+	if !ok {
+		return defaultIntResponse, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: int64", data)}
+	}
+	return value, nil
+}
+
+func convertBoolValue(data interface{}) (bool, error) {
+	switch value := data.(type) {
+	case bool:
+		return value, nil
+	case int64:
+		return value == 1, nil
+	}
+	return defaultBoolResponse, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: bool", data)}
+}
+
+func convertClientInfo(data interface{}) (ClientInfo, error) {
+	line, err := convertStringValue(data)
+	if err != nil {
+		return ClientInfo{}, err
+	}
+	return parseClientInfo(strings.TrimSpace(line))
+}
+
+func convertClientList(data interface{}) ([]ClientInfo, error) {
+	lines, err := convertStringValue(data)
+	if err != nil {
+		return nil, err
+	}
+	clients := []ClientInfo{}
+	for _, line := range strings.Split(lines, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		info, err := parseClientInfo(line)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, info)
+	}
+	return clients, nil
+}
+
+// parseClientInfo parses a connection described by `CLIENT INFO` and `CLIENT LIST` as space separated "name=value" fields.
+func parseClientInfo(line string) (ClientInfo, error) {
+	fields := make(map[string]string)
+	for _, field := range strings.Fields(line) {
+		name, value, found := strings.Cut(field, "=")
+		if !found {
+			return ClientInfo{}, &errors.RequestError{Msg: "unexpected client field: " + field}
+		}
+		fields[name] = value
+	}
+	info := ClientInfo{
+		Addr:       fields["addr"],
+		LocalAddr:  fields["laddr"],
+		Name:       fields["name"],
+		Flags:      fields["flags"],
+		Cmd:        fields["cmd"],
+		User:       fields["user"],
+		LibName:    fields["lib-name"],
+		LibVersion: fields["lib-ver"],
+		Fields:     fields,
+	}
+	for name, value := range map[string]*int64{"id": &info.Id, "age": &info.Age, "idle": &info.Idle, "db": &info.Db} {
+		if fields[name] == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(fields[name], 10, 64)
+		if err != nil {
+			return ClientInfo{}, &errors.RequestError{Msg: fmt.Sprintf("unexpected client %s: %s", name, fields[name])}
+		}
+		*value = parsed
+	}
+	return info, nil
+}
+
+// handleAllNodesSumResponse sums the responses of the nodes to a command sent to multiple nodes.
+func handleAllNodesSumResponse(response *C.struct_CommandResponse) (int64, error) {
+	data, err := handleInterfaceResponse(response)
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	nodes, ok := data.(map[string]interface{})
+	if !ok {
+		return convertIntValue(data)
+	}
+	var total int64
+	for _, nodeData := range nodes {
+		value, err := convertIntValue(nodeData)
+		if err != nil {
+			return defaultIntResponse, err
+		}
+		total += value
+	}
+	return total, nil
+}
+
+func convertSlowLog(data interface{}) ([]SlowLogEntry, error) {
+	items, ok := data.([]interface{})
+	if !ok && data != nil {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: array", data)}
+	}
+	entries := make([]SlowLogEntry, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields) < 4 {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected slow log entry: %v", item)}
+		}
+		entry := SlowLogEntry{}
+		entry.Id, _ = fields[0].(int64)
+		entry.Timestamp, _ = fields[1].(int64)
+		entry.ExecutionTime, _ = fields[2].(int64)
+		args, err := convertStringArrayValue(fields[3])
+		if err != nil {
+			return nil, err
+		}
+		entry.Args = args
+		if len(fields) >= 6 {
+			entry.ClientAddr, _ = fields[4].(string)
+			entry.ClientName, _ = fields[5].(string)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func convertLatencyLatest(data interface{}) ([]LatencyEvent, error) {
+	items, ok := data.([]interface{})
+	if !ok && data != nil {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: array", data)}
+	}
+	events := make([]LatencyEvent, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields) < 4 {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected latency event: %v", item)}
+		}
+		event := LatencyEvent{}
+		event.Name, _ = fields[0].(string)
+		event.Timestamp, _ = fields[1].(int64)
+		event.Latest, _ = fields[2].(int64)
+		event.Max, _ = fields[3].(int64)
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func convertLatencyHistory(data interface{}) ([]LatencySample, error) {
+	items, ok := data.([]interface{})
+	if !ok && data != nil {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: array", data)}
+	}
+	samples := make([]LatencySample, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields) < 2 {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected latency sample: %v", item)}
+		}
+		sample := LatencySample{}
+		sample.Timestamp, _ = fields[0].(int64)
+		sample.Latency, _ = fields[1].(int64)
+		samples = append(samples, sample)
+	}
+	return samples, nil
+}
+
+func convertLatencyHistogram(data interface{}) (map[string]CommandHistogram, error) {
+	commands, ok := data.(map[string]interface{})
+	if !ok && data != nil {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: map", data)}
+	}
+	histograms := make(map[string]CommandHistogram, len(commands))
+	for command, commandData := range commands {
+		fields, ok := commandData.(map[string]interface{})
+		if !ok {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected histogram of %s: %v", command, commandData)}
+		}
+		histogram := CommandHistogram{Buckets: make(map[int64]int64)}
+		histogram.Calls, _ = fields["calls"].(int64)
+		buckets, _ := fields["histogram_usec"].(map[string]interface{})
+		for bound, count := range buckets {
+			parsed, err := strconv.ParseInt(bound, 10, 64)
+			if err != nil {
+				return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected histogram bucket of %s: %s", command, bound)}
+			}
+			histogram.Buckets[parsed], _ = count.(int64)
+		}
+		histograms[command] = histogram
+	}
+	return histograms, nil
+}
+
+func convertMemoryStats(data interface{}) (MemoryStats, error) {
+	fields, ok := data.(map[string]interface{})
+	if !ok {
+		return MemoryStats{}, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: map", data)}
+	}
+	stats := MemoryStats{Fields: fields}
+	stats.PeakAllocated, _ = fields["peak.allocated"].(int64)
+	stats.TotalAllocated, _ = fields["total.allocated"].(int64)
+	stats.StartupAllocated, _ = fields["startup.allocated"].(int64)
+	stats.OverheadTotal, _ = fields["overhead.total"].(int64)
+	stats.KeysCount, _ = fields["keys.count"].(int64)
+	stats.DatasetBytes, _ = fields["dataset.bytes"].(int64)
+	stats.Fragmentation, _ = fields["fragmentation"].(float64)
+	stats.FragmentationBytes, _ = fields["fragmentation.bytes"].(int64)
+	return stats, nil
+}
+
+// intField returns the integer value of a field of a map reply, or 0 when it is missing.
+func intField(fields map[string]interface{}, name string) int64 {
+	value, _ := fields[name].(int64)
+	return value
+}
+
+func convertClusterState(data interface{}) (ClusterState, error) {
+	text, err := convertStringValue(data)
+	if err != nil {
+		return ClusterState{}, err
+	}
+	state := ClusterState{Fields: make(map[string]string)}
+	for _, line := range strings.Split(text, "\n") {
+		name, value, ok := strings.Cut(strings.TrimSpace(line), ":")
+		if ok {
+			state.Fields[name] = value
+		}
+	}
+	state.State = state.Fields["cluster_state"]
+	for name, value := range map[string]*int64{
+		"cluster_slots_assigned": &state.SlotsAssigned,
+		"cluster_slots_ok":       &state.SlotsOk,
+		"cluster_slots_pfail":    &state.SlotsPfail,
+		"cluster_slots_fail":     &state.SlotsFail,
+		"cluster_known_nodes":    &state.KnownNodes,
+		"cluster_size":           &state.Size,
+		"cluster_current_epoch":  &state.CurrentEpoch,
+		"cluster_my_epoch":       &state.MyEpoch,
+	} {
+		if field, ok := state.Fields[name]; ok {
+			parsed, err := strconv.ParseInt(field, 10, 64)
+			if err != nil {
+				return ClusterState{}, &errors.RequestError{Msg: fmt.Sprintf("unexpected %s: %s", name, field)}
+			}
+			*value = parsed
+		}
+	}
+	return state, nil
+}
+
+func convertClusterNodes(data interface{}) ([]ClusterNode, error) {
+	text, err := convertStringValue(data)
+	if err != nil {
+		return nil, err
+	}
+	nodes := []ClusterNode{}
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line == "" {
+			continue
+		}
+		node, err := parseClusterNode(line)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// parseClusterNode parses a line of the response of CLUSTER NODES, in the format
+// "id ip:port@cport[,hostname] flags primary ping-sent pong-recv config-epoch link-state [slot ...]".
+func parseClusterNode(line string) (ClusterNode, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 8 {
+		return ClusterNode{}, &errors.RequestError{Msg: "unexpected cluster node: " + line}
+	}
+	node := ClusterNode{
+		Id:        fields[0],
+		Flags:     strings.Split(fields[2], ","),
+		Connected: fields[7] == "connected",
+		Slots:     []SlotRange{},
+	}
+	address, hostname, _ := strings.Cut(fields[1], ",")
+	node.Hostname = hostname
+	address, busPort, ok := strings.Cut(address, "@")
+	node.Address = address
+	if ok {
+		node.BusPort, _ = strconv.ParseInt(busPort, 10, 64)
+	}
+	if fields[3] != "-" {
+		node.PrimaryId = fields[3]
+	}
+	for i, value := range []*int64{&node.PingSent, &node.PongReceived, &node.ConfigEpoch} {
+		parsed, err := strconv.ParseInt(fields[4+i], 10, 64)
+		if err != nil {
+			return ClusterNode{}, &errors.RequestError{Msg: "unexpected cluster node: " + line}
+		}
+		*value = parsed
+	}
+	for _, slots := range fields[8:] {
+		// Slots being imported or migrated are listed as "[slot-<-id]" and "[slot->-id]".
+		if strings.HasPrefix(slots, "[") {
+			continue
+		}
+		start, end, isRange := strings.Cut(slots, "-")
+		if !isRange {
+			end = start
+		}
+		startSlot, startErr := strconv.ParseInt(start, 10, 64)
+		endSlot, endErr := strconv.ParseInt(end, 10, 64)
+		if startErr != nil || endErr != nil {
+			return ClusterNode{}, &errors.RequestError{Msg: "unexpected cluster node slots: " + slots}
+		}
+		node.Slots = append(node.Slots, SlotRange{Start: startSlot, End: endSlot})
+	}
+	return node, nil
+}
+
+func convertClusterSlots(data interface{}) ([]ClusterSlotsEntry, error) {
+	items, ok := data.([]interface{})
+	if !ok && data != nil {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: array", data)}
+	}
+	entries := make([]ClusterSlotsEntry, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields) < 3 {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected slot range: %v", item)}
+		}
+		entry := ClusterSlotsEntry{Replicas: []ClusterSlotsNode{}}
+		entry.Slots.Start, _ = fields[0].(int64)
+		entry.Slots.End, _ = fields[1].(int64)
+		for i, nodeData := range fields[2:] {
+			node, err := convertClusterSlotsNode(nodeData)
+			if err != nil {
+				return nil, err
+			}
+			if i == 0 {
+				entry.Primary = node
+			} else {
+				entry.Replicas = append(entry.Replicas, node)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func convertClusterSlotsNode(data interface{}) (ClusterSlotsNode, error) {
+	fields, ok := data.([]interface{})
+	if !ok || len(fields) < 2 {
+		return ClusterSlotsNode{}, &errors.RequestError{Msg: fmt.Sprintf("unexpected slot range node: %v", data)}
+	}
+	node := ClusterSlotsNode{}
+	node.Endpoint, _ = fields[0].(string)
+	node.Port, _ = fields[1].(int64)
+	if len(fields) > 2 {
+		node.Id, _ = fields[2].(string)
+	}
+	if len(fields) > 3 {
+		// The metadata of the node is a map, or a flat array of names and values with RESP2.
+		switch metadata := fields[3].(type) {
+		case map[string]interface{}:
+			node.Hostname = stringField(metadata, "hostname")
+		case []interface{}:
+			for i := 0; i+1 < len(metadata); i += 2 {
+				if metadata[i] == "hostname" {
+					node.Hostname, _ = metadata[i+1].(string)
+				}
+			}
+		}
+	}
+	return node, nil
+}
+
+func convertClusterShards(data interface{}) ([]ClusterShard, error) {
+	items, ok := data.([]interface{})
+	if !ok && data != nil {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: array", data)}
+	}
+	shards := make([]ClusterShard, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type of shard: %T", item)}
+		}
+		shard := ClusterShard{Slots: []SlotRange{}, Nodes: []ClusterShardNode{}}
+		// The slots are a flat array of the first and last slot of every range.
+		slots, _ := fields["slots"].([]interface{})
+		for i := 0; i+1 < len(slots); i += 2 {
+			start, startOk := slots[i].(int64)
+			end, endOk := slots[i+1].(int64)
+			if !startOk || !endOk {
+				return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected shard slots: %v", slots)}
+			}
+			shard.Slots = append(shard.Slots, SlotRange{Start: start, End: end})
+		}
+		nodes, _ := fields["nodes"].([]interface{})
+		for _, nodeData := range nodes {
+			nodeFields, ok := nodeData.(map[string]interface{})
+			if !ok {
+				return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type of shard node: %T", nodeData)}
+			}
+			shard.Nodes = append(shard.Nodes, ClusterShardNode{
+				Id:                stringField(nodeFields, "id"),
+				Endpoint:          stringField(nodeFields, "endpoint"),
+				Ip:                stringField(nodeFields, "ip"),
+				Hostname:          stringField(nodeFields, "hostname"),
+				Port:              intField(nodeFields, "port"),
+				TlsPort:           intField(nodeFields, "tls-port"),
+				Role:              stringField(nodeFields, "role"),
+				ReplicationOffset: intField(nodeFields, "replication-offset"),
+				Health:            stringField(nodeFields, "health"),
+			})
+		}
+		shards = append(shards, shard)
+	}
+	return shards, nil
+}
+
+func convertClusterLinks(data interface{}) ([]ClusterLink, error) {
+	items, ok := data.([]interface{})
+	if !ok && data != nil {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: array", data)}
+	}
+	links := make([]ClusterLink, 0, len(items))
+	for _, item := range items {
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type of link: %T", item)}
+		}
+		links = append(links, ClusterLink{
+			Direction:           stringField(fields, "direction"),
+			Node:                stringField(fields, "node"),
+			CreateTime:          intField(fields, "create-time"),
+			Events:              stringField(fields, "events"),
+			SendBufferAllocated: intField(fields, "send-buffer-allocated"),
+			SendBufferUsed:      intField(fields, "send-buffer-used"),
+		})
+	}
+	return links, nil
+}
+
+func convertSlotStats(data interface{}) ([]SlotStats, error) {
+	items, ok := data.([]interface{})
+	if !ok && data != nil {
+		return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected type: %T, expected: array", data)}
+	}
+	stats := make([]SlotStats, 0, len(items))
+	for _, item := range items {
+		pair, ok := item.([]interface{})
+		if !ok || len(pair) != 2 {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected slot stats: %v", item)}
+		}
+		slot, slotOk := pair[0].(int64)
+		fields, fieldsOk := pair[1].(map[string]interface{})
+		if !slotOk || !fieldsOk {
+			return nil, &errors.RequestError{Msg: fmt.Sprintf("unexpected slot stats: %v", item)}
+		}
+		stats = append(stats, SlotStats{
+			Slot:            slot,
+			KeyCount:        intField(fields, "key-count"),
+			CpuUsec:         intField(fields, "cpu-usec"),
+			NetworkBytesIn:  intField(fields, "network-bytes-in"),
+			NetworkBytesOut: intField(fields, "network-bytes-out"),
+		})
+	}
+	return stats, nil
+}
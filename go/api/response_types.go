@@ -2,6 +2,12 @@
 
 package api
 
+import (
+	"net"
+	"slices"
+	"strconv"
+)
+
 // A value to return alongside with error in case if command failed
 var (
 	defaultFloatResponse  float64
@@ -283,3 +289,397 @@ type XInfoGroupInfo struct {
 	// Included in the response only on valkey 7.0.0 and above.
 	Lag Result[int64]
 }
+
+// AclUser represents the rules of a user returned by `AclGetUser` command.
+type AclUser struct {
+	// The flags of the user, such as "on", "off" or "nopass".
+	Flags []string
+	// The SHA-256 hashes of the passwords of the user.
+	Passwords []string
+	// The commands the user may call, described as rules, such as "+@all -debug".
+	Commands string
+	// The key patterns the user may access, described as rules, such as "~* %R~cache:*".
+	Keys string
+	// The Pub/Sub channel patterns the user may access, described as rules, such as "&*".
+	Channels string
+	// The selectors of the user, allowing commands not allowed by its own rules.
+	Selectors []AclSelector
+}
+
+// AclSelector represents a selector of a user returned by `AclGetUser` command.
+type AclSelector struct {
+	// The commands the selector allows, described as rules.
+	Commands string
+	// The key patterns the selector allows, described as rules.
+	Keys string
+	// The Pub/Sub channel patterns the selector allows, described as rules.
+	Channels string
+}
+
+// AclLogEntry represents a security event returned by `AclLog` command.
+type AclLogEntry struct {
+	// The number of similar events logged within 60 seconds, which were merged into this entry.
+	Count int64
+	// The reason of the event: "command", "key", "channel" or "auth".
+	Reason string
+	// The context the command was called in: "toplevel", "multi", "lua" or "module".
+	Context string
+	// The command, key or channel which was denied, or "AUTH" for authentication failures.
+	Object string
+	// The user the client was authenticated as, or tried to authenticate as.
+	Username string
+	// The number of seconds since the event was last logged.
+	AgeSeconds float64
+	// The information about the client which caused the event, in the format of `CLIENT LIST`.
+	ClientInfo string
+	// The unique identifier of the entry.
+	// Included in the response only on valkey 7.2.0 and above.
+	EntryId Result[int64]
+	// The time in milliseconds since the Unix epoch at which the entry was first logged.
+	// Included in the response only on valkey 7.2.0 and above.
+	TimestampCreated Result[int64]
+	// The time in milliseconds since the Unix epoch at which the entry was last updated.
+	// Included in the response only on valkey 7.2.0 and above.
+	TimestampLastUpdated Result[int64]
+}
+
+func CreateAclUserResult(user AclUser) Result[AclUser] {
+	return Result[AclUser]{val: user, isNil: false}
+}
+
+func CreateNilAclUserResult() Result[AclUser] {
+	return Result[AclUser]{isNil: true}
+}
+
+// ClientInfo represents a connection to the server returned by `ClientInfo` and `ClientList` commands.
+type ClientInfo struct {
+	// The unique identifier of the connection.
+	Id int64
+	// The address of the client, in the format "ip:port".
+	Addr string
+	// The address of the server the client is connected to, in the format "ip:port".
+	LocalAddr string
+	// The name of the connection, as set by `ClientSetName`.
+	Name string
+	// The number of seconds since the connection was opened.
+	Age int64
+	// The number of seconds the connection has been idle for.
+	Idle int64
+	// The flags of the connection, one character each. See [valkey.io] for their meaning.
+	//
+	// [valkey.io]: https://valkey.io/commands/client-list/
+	Flags string
+	// The selected database.
+	Db int64
+	// The last command sent by the client.
+	Cmd string
+	// The user the connection is authenticated as.
+	User string
+	// The client library name, as set by `ClientSetInfo`.
+	LibName string
+	// The client library version, as set by `ClientSetInfo`.
+	LibVersion string
+	// All the fields of the connection, including the ones above, by name.
+	Fields map[string]string
+}
+
+// SlowLogEntry represents a command logged by the server for exceeding the configured execution time, returned by
+// `SlowLogGet` command.
+type SlowLogEntry struct {
+	// The unique identifier of the entry.
+	Id int64
+	// The Unix time in seconds at which the command was logged.
+	Timestamp int64
+	// The execution time of the command in microseconds.
+	ExecutionTime int64
+	// The command and its arguments, which may be truncated by the server.
+	Args []string
+	// The address of the client, in the format "ip:port".
+	ClientAddr string
+	// The name of the client connection, as set by `ClientSetName`.
+	ClientName string
+}
+
+// LatencyEvent represents the latest latency spike of an event, returned by `LatencyLatest` command.
+type LatencyEvent struct {
+	// The name of the event, such as "command" or "fork".
+	Name string
+	// The Unix time in seconds of the latest spike.
+	Timestamp int64
+	// The latency of the latest spike in milliseconds.
+	Latest int64
+	// The highest latency of the event in milliseconds.
+	Max int64
+}
+
+// LatencySample represents a latency spike of an event, returned by `LatencyHistory` command.
+type LatencySample struct {
+	// The Unix time in seconds of the spike.
+	Timestamp int64
+	// The latency of the spike in milliseconds.
+	Latency int64
+}
+
+// CommandHistogram represents the latency distribution of a command, returned by `LatencyHistogram` command.
+type CommandHistogram struct {
+	// The number of calls of the command.
+	Calls int64
+	// The number of calls with a latency up to every bucket, keyed by the upper bound of the bucket in microseconds.
+	Buckets map[int64]int64
+}
+
+// MemoryStats represents the memory usage of the server, returned by `MemoryStats` command.
+type MemoryStats struct {
+	// The peak memory allocated by the server, in bytes.
+	PeakAllocated int64
+	// The memory allocated by the server, in bytes.
+	TotalAllocated int64
+	// The memory allocated by the server at startup, in bytes.
+	StartupAllocated int64
+	// The memory overhead of the server, in bytes, such as the memory of the clients and the replication backlog.
+	OverheadTotal int64
+	// The number of keys of all the databases.
+	KeysCount int64
+	// The memory used by the data, in bytes.
+	DatasetBytes int64
+	// The ratio of the memory allocated by the operating system to the memory allocated by the server.
+	Fragmentation float64
+	// The difference between the memory allocated by the operating system and by the server, in bytes.
+	FragmentationBytes int64
+	// All the statistics, including the ones above, by name.
+	Fields map[string]interface{}
+}
+
+// SlotRange represents a range of hash slots of a cluster, from Start to End, both included.
+type SlotRange struct {
+	Start int64
+	End   int64
+}
+
+// ClusterState represents the state of a cluster as seen by a node, returned by `ClusterInfo` command.
+type ClusterState struct {
+	// "ok" when the node can serve queries, or "fail" when at least one slot is unassigned or served by a failing node.
+	State string
+	// The number of slots assigned to a node.
+	SlotsAssigned int64
+	// The number of slots served by a node which isn't failing.
+	SlotsOk int64
+	// The number of slots served by a node suspected to be failing.
+	SlotsPfail int64
+	// The number of slots served by a node failing.
+	SlotsFail int64
+	// The number of nodes of the cluster, including the nodes in handshake state.
+	KnownNodes int64
+	// The number of shards serving at least one slot.
+	Size int64
+	// The highest configuration epoch known by the node.
+	CurrentEpoch int64
+	// The configuration epoch of the node.
+	MyEpoch int64
+	// All the fields, including the ones above, by name.
+	Fields map[string]string
+}
+
+// ClusterNode represents a node of a cluster, returned by `ClusterNodes` command.
+type ClusterNode struct {
+	Id string
+	// The address of the node, in the format "ip:port".
+	Address string
+	// The port of the cluster bus of the node.
+	BusPort int64
+	// The hostname of the node, or an empty string when it isn't configured.
+	Hostname string
+	// The flags of the node, such as "myself", "master", "slave", "fail?" and "fail".
+	Flags []string
+	// The id of the primary of the node when it is a replica, or an empty string otherwise.
+	PrimaryId string
+	// The time the last ping was sent, in milliseconds since the Unix epoch, or 0 when there is no pending ping.
+	PingSent int64
+	// The time the last pong was received, in milliseconds since the Unix epoch.
+	PongReceived int64
+	ConfigEpoch  int64
+	// Whether the link to the node of the cluster bus is connected.
+	Connected bool
+	// The slots served by the node. Slots being migrated are not included.
+	Slots []SlotRange
+}
+
+// IsPrimary returns whether the node is a primary.
+func (node ClusterNode) IsPrimary() bool {
+	return slices.Contains(node.Flags, "master")
+}
+
+// IsMyself returns whether the node is the node which returned it.
+func (node ClusterNode) IsMyself() bool {
+	return slices.Contains(node.Flags, "myself")
+}
+
+// IsFailing returns whether the node is failing, or suspected to be failing.
+func (node ClusterNode) IsFailing() bool {
+	return slices.Contains(node.Flags, "fail") || slices.Contains(node.Flags, "fail?")
+}
+
+// ClusterSlotsEntry represents a range of slots and the nodes serving it, returned by `ClusterSlots` command.
+type ClusterSlotsEntry struct {
+	Slots    SlotRange
+	Primary  ClusterSlotsNode
+	Replicas []ClusterSlotsNode
+}
+
+// ClusterSlotsNode represents a node serving a range of slots, returned by `ClusterSlots` command.
+type ClusterSlotsNode struct {
+	// The preferred endpoint of the node, usually its ip.
+	Endpoint string
+	Port     int64
+	Id       string
+	// The hostname of the node, or an empty string when it isn't configured.
+	Hostname string
+}
+
+// ClusterShard represents a shard of a cluster and the nodes serving it, returned by `ClusterShards` command.
+type ClusterShard struct {
+	Slots []SlotRange
+	Nodes []ClusterShardNode
+}
+
+// Primary returns the primary of the shard, and whether the shard has one.
+func (shard ClusterShard) Primary() (ClusterShardNode, bool) {
+	for _, node := range shard.Nodes {
+		if node.Role == "master" {
+			return node, true
+		}
+	}
+	return ClusterShardNode{}, false
+}
+
+// Replicas returns the replicas of the shard.
+func (shard ClusterShard) Replicas() []ClusterShardNode {
+	replicas := []ClusterShardNode{}
+	for _, node := range shard.Nodes {
+		if node.Role != "master" {
+			replicas = append(replicas, node)
+		}
+	}
+	return replicas
+}
+
+// ContainsSlot returns whether the shard serves slot.
+func (shard ClusterShard) ContainsSlot(slot int64) bool {
+	for _, slotRange := range shard.Slots {
+		if slot >= slotRange.Start && slot <= slotRange.End {
+			return true
+		}
+	}
+	return false
+}
+
+// ClusterShardNode represents a node of a shard, returned by `ClusterShards` command.
+type ClusterShardNode struct {
+	Id string
+	// The preferred endpoint of the node, usually its ip.
+	Endpoint string
+	Ip       string
+	// The hostname of the node, or an empty string when it isn't configured.
+	Hostname string
+	// The port of the node, or 0 when the node only accepts TLS connections.
+	Port int64
+	// The TLS port of the node, or 0 when TLS isn't enabled.
+	TlsPort int64
+	// "master" or "replica".
+	Role string
+	// The replication offset of the node.
+	ReplicationOffset int64
+	// "online", "failed" or "loading".
+	Health string
+}
+
+// Address returns the address of the node, in the format "endpoint:port". The TLS port is used when the node only
+// accepts TLS connections.
+func (node ClusterShardNode) Address() string {
+	port := node.Port
+	if port == 0 {
+		port = node.TlsPort
+	}
+	return net.JoinHostPort(node.Endpoint, strconv.FormatInt(port, 10))
+}
+
+// ClusterTopology represents the shards of a cluster, returned by `Topology`.
+type ClusterTopology struct {
+	Shards []ClusterShard
+}
+
+// ShardOfSlot returns the shard serving slot, and whether the slot is assigned.
+func (topology ClusterTopology) ShardOfSlot(slot int64) (ClusterShard, bool) {
+	for _, shard := range topology.Shards {
+		if shard.ContainsSlot(slot) {
+			return shard, true
+		}
+	}
+	return ClusterShard{}, false
+}
+
+// Primaries returns the primaries of all the shards.
+func (topology ClusterTopology) Primaries() []ClusterShardNode {
+	primaries := []ClusterShardNode{}
+	for _, shard := range topology.Shards {
+		if primary, ok := shard.Primary(); ok {
+			primaries = append(primaries, primary)
+		}
+	}
+	return primaries
+}
+
+// Replicas returns the replicas of all the shards.
+func (topology ClusterTopology) Replicas() []ClusterShardNode {
+	replicas := []ClusterShardNode{}
+	for _, shard := range topology.Shards {
+		replicas = append(replicas, shard.Replicas()...)
+	}
+	return replicas
+}
+
+// IsHealthy returns whether every slot is served by a shard with an online primary.
+func (topology ClusterTopology) IsHealthy() bool {
+	covered := int64(0)
+	for _, shard := range topology.Shards {
+		if len(shard.Slots) == 0 {
+			continue
+		}
+		if primary, ok := shard.Primary(); !ok || primary.Health != "online" {
+			return false
+		}
+		for _, slotRange := range shard.Slots {
+			covered += slotRange.End - slotRange.Start + 1
+		}
+	}
+	return covered == slotCount
+}
+
+// ClusterLink represents a connection of the cluster bus between the node and another node, returned by `ClusterLinks`
+// command.
+type ClusterLink struct {
+	// "to" for the connection opened by the node, or "from" for the connection accepted by the node.
+	Direction string
+	// The id of the other node.
+	Node string
+	// The time the connection was created, in milliseconds since the Unix epoch.
+	CreateTime int64
+	// The events the node is waiting for on the connection, such as "r" and "w".
+	Events string
+	// The memory allocated for the send buffer of the connection, in bytes.
+	SendBufferAllocated int64
+	// The memory used by the send buffer of the connection, in bytes.
+	SendBufferUsed int64
+}
+
+// SlotStats represents the statistics of a slot, returned by `ClusterSlotStats` command.
+type SlotStats struct {
+	Slot     int64
+	KeyCount int64
+	// The CPU time spent on the slot, in microseconds. Only collected when `cluster-slot-stats-enabled` is set.
+	CpuUsec int64
+	// The number of bytes received for the slot. Only collected when `cluster-slot-stats-enabled` is set.
+	NetworkBytesIn int64
+	// The number of bytes sent for the slot. Only collected when `cluster-slot-stats-enabled` is set.
+	NetworkBytesOut int64
+}
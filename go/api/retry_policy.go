@@ -0,0 +1,283 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	goErrors "errors"
+	"fmt"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+// RetryableErrors is a set of error classes that a [RetryPolicy] retries. Classes can be combined with the | operator.
+type RetryableErrors int
+
+const (
+	// RetryOnTimeout retries commands that failed with a [errors.TimeoutError].
+	RetryOnTimeout RetryableErrors = 1 << iota
+	// RetryOnConnectionError retries commands that failed with a [errors.ConnectionError] or a [errors.DisconnectError].
+	RetryOnConnectionError
+	// RetryOnTryAgain retries commands rejected by the server with a transient error, such as TRYAGAIN, LOADING,
+	// CLUSTERDOWN or MASTERDOWN.
+	RetryOnTryAgain
+)
+
+// DefaultRetryableErrors is the set of error classes retried by a [RetryPolicy] when none is configured.
+const DefaultRetryableErrors = RetryOnTimeout | RetryOnConnectionError
+
+const (
+	defaultRetryInitialBackoff = 10 * time.Millisecond
+	defaultRetryMaxBackoff     = time.Second
+)
+
+// RetryPolicy configures how the client retries commands that failed with a transient error.
+//
+// Only commands that are safe to send more than once are retried: read-only commands, identified by their request type,
+// and commands executed with a context returned by [WithRetry]. Other commands, such as INCR or LPUSH, are never retried
+// automatically, since a timeout doesn't tell whether the server applied them.
+//
+// When a command is attempted more than once and the last attempt fails, the returned error is a [errors.RetryError]
+// holding the number of attempts and unwrapping to the error of the last attempt. Commands are not retried once the context
+// they were executed with is done, and the error of the last attempt is returned, such as the [errors.TimeoutError] of a
+// context whose deadline passed.
+type RetryPolicy struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	retryOn        RetryableErrors
+}
+
+// NewRetryPolicy returns a [RetryPolicy] that sends a command at most maxAttempts times, including the first attempt. The
+// policy retries [DefaultRetryableErrors] with an exponential backoff starting at 10 milliseconds and capped at 1 second.
+// For further configuration, use the [RetryPolicy] With* methods.
+func NewRetryPolicy(maxAttempts int) *RetryPolicy {
+	return &RetryPolicy{
+		maxAttempts:    maxAttempts,
+		initialBackoff: defaultRetryInitialBackoff,
+		maxBackoff:     defaultRetryMaxBackoff,
+		retryOn:        DefaultRetryableErrors,
+	}
+}
+
+// WithBackoff sets the time to wait before the first retry, which is doubled for every following retry up to maxBackoff.
+func (policy *RetryPolicy) WithBackoff(initialBackoff time.Duration, maxBackoff time.Duration) *RetryPolicy {
+	policy.initialBackoff = initialBackoff
+	policy.maxBackoff = maxBackoff
+	return policy
+}
+
+// WithRetryableErrors sets the classes of errors that are retried.
+func (policy *RetryPolicy) WithRetryableErrors(retryOn RetryableErrors) *RetryPolicy {
+	policy.retryOn = retryOn
+	return policy
+}
+
+func (policy *RetryPolicy) validate() error {
+	if policy.maxAttempts < 1 {
+		return &errors.ConfigurationError{Msg: fmt.Sprintf("invalid retry max attempts %d", policy.maxAttempts)}
+	}
+	if policy.initialBackoff < 0 || policy.maxBackoff < policy.initialBackoff {
+		return &errors.ConfigurationError{
+			Msg: fmt.Sprintf("invalid retry backoff %v with max %v", policy.initialBackoff, policy.maxBackoff),
+		}
+	}
+	return nil
+}
+
+// appliesTo reports whether a command of the given request type, executed with ctx, may be retried.
+func (policy *RetryPolicy) appliesTo(ctx context.Context, requestType protobuf.RequestType) bool {
+	if policy.maxAttempts <= 1 {
+		return false
+	}
+	if retry, ok := ctx.Value(retryContextKey{}).(bool); ok {
+		return retry
+	}
+	_, ok := idempotentRequestTypes[requestType]
+	return ok
+}
+
+// isRetryable reports whether err belongs to one of the classes retried by the policy.
+func (policy *RetryPolicy) isRetryable(err error) bool {
+	if policy.retryOn&RetryOnTimeout != 0 {
+		var timeoutErr *errors.TimeoutError
+		if goErrors.As(err, &timeoutErr) {
+			return true
+		}
+	}
+	if policy.retryOn&RetryOnConnectionError != 0 {
+		var connectionErr *errors.ConnectionError
+		var disconnectErr *errors.DisconnectError
+		if goErrors.As(err, &connectionErr) || goErrors.As(err, &disconnectErr) {
+			return true
+		}
+	}
+	if policy.retryOn&RetryOnTryAgain != 0 {
+		for _, sentinel := range []error{errors.ErrTryAgain, errors.ErrLoading, errors.ErrClusterDown, errors.ErrMasterDown} {
+			if goErrors.Is(err, sentinel) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// backoff returns the time to wait after the given failed attempt, starting at 1.
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := policy.initialBackoff
+	for i := 1; i < attempt && delay < policy.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, policy.maxBackoff)
+}
+
+// wait sleeps for the backoff of the given failed attempt, or until ctx is done.
+func (policy *RetryPolicy) wait(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(policy.backoff(attempt))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// retry runs attempt until it succeeds, fails with an error which isn't retried by policy, or was run policy.maxAttempts
+// times. Once ctx is done, the error of the attempt is returned without retrying it: a timeout then comes from the deadline
+// of the caller rather than from the request timeout of the client.
+func retry[T any](ctx context.Context, policy *RetryPolicy, attempt func() (T, error)) (T, error) {
+	for attempts := 1; ; attempts++ {
+		value, err := attempt()
+		if err == nil {
+			return value, nil
+		}
+		retryable := attempts < policy.maxAttempts && ctx.Err() == nil && policy.isRetryable(err)
+		// The wait fails when ctx is done before the next attempt.
+		if retryable && policy.wait(ctx, attempts) == nil {
+			continue
+		}
+		if attempts == 1 {
+			return value, err
+		}
+		return value, &errors.RetryError{Attempts: attempts, Err: err}
+	}
+}
+
+type retryContextKey struct{}
+
+// WithRetry returns a copy of ctx that overrides the client's [RetryPolicy] for the commands executed with it.
+//
+// When retry is true, the command is retried according to the policy even if it isn't read-only, meaning the caller asserts
+// that sending it more than once is safe. When retry is false, the command is never retried. Without a retry policy
+// configured on the client, WithRetry has no effect.
+//
+// For example:
+//
+//	// SET with a fixed value can safely be applied twice.
+//	result, err := client.Set(api.WithRetry(ctx, true), "key", "value")
+func WithRetry(ctx context.Context, retry bool) context.Context {
+	return context.WithValue(ctx, retryContextKey{}, retry)
+}
+
+// idempotentRequestTypes holds the read-only commands, which are retried by a [RetryPolicy] without an explicit opt-in.
+var idempotentRequestTypes = map[protobuf.RequestType]struct{}{
+	protobuf.RequestType_BitCount:                  {},
+	protobuf.RequestType_BitFieldReadOnly:          {},
+	protobuf.RequestType_BitPos:                    {},
+	protobuf.RequestType_ClientGetName:             {},
+	protobuf.RequestType_ClientId:                  {},
+	protobuf.RequestType_ConfigGet:                 {},
+	protobuf.RequestType_DBSize:                    {},
+	protobuf.RequestType_Dump:                      {},
+	protobuf.RequestType_Echo:                      {},
+	protobuf.RequestType_EvalReadOnly:              {},
+	protobuf.RequestType_EvalShaReadOnly:           {},
+	protobuf.RequestType_Exists:                    {},
+	protobuf.RequestType_ExpireTime:                {},
+	protobuf.RequestType_FCallReadOnly:             {},
+	protobuf.RequestType_FunctionList:              {},
+	protobuf.RequestType_FunctionStats:             {},
+	protobuf.RequestType_GeoDist:                   {},
+	protobuf.RequestType_GeoHash:                   {},
+	protobuf.RequestType_GeoPos:                    {},
+	protobuf.RequestType_GeoRadiusByMemberReadOnly: {},
+	protobuf.RequestType_GeoRadiusReadOnly:         {},
+	protobuf.RequestType_GeoSearch:                 {},
+	protobuf.RequestType_Get:                       {},
+	protobuf.RequestType_GetBit:                    {},
+	protobuf.RequestType_GetRange:                  {},
+	protobuf.RequestType_HExists:                   {},
+	protobuf.RequestType_HGet:                      {},
+	protobuf.RequestType_HGetAll:                   {},
+	protobuf.RequestType_HKeys:                     {},
+	protobuf.RequestType_HLen:                      {},
+	protobuf.RequestType_HMGet:                     {},
+	protobuf.RequestType_HRandField:                {},
+	protobuf.RequestType_HScan:                     {},
+	protobuf.RequestType_HStrlen:                   {},
+	protobuf.RequestType_HVals:                     {},
+	protobuf.RequestType_Info:                      {},
+	protobuf.RequestType_Keys:                      {},
+	protobuf.RequestType_LCS:                       {},
+	protobuf.RequestType_LIndex:                    {},
+	protobuf.RequestType_LLen:                      {},
+	protobuf.RequestType_LPos:                      {},
+	protobuf.RequestType_LRange:                    {},
+	protobuf.RequestType_LastSave:                  {},
+	protobuf.RequestType_MGet:                      {},
+	protobuf.RequestType_ObjectEncoding:            {},
+	protobuf.RequestType_ObjectFreq:                {},
+	protobuf.RequestType_ObjectIdleTime:            {},
+	protobuf.RequestType_ObjectRefCount:            {},
+	protobuf.RequestType_PExpireTime:               {},
+	protobuf.RequestType_PTTL:                      {},
+	protobuf.RequestType_PfCount:                   {},
+	protobuf.RequestType_Ping:                      {},
+	protobuf.RequestType_PubSubChannels:            {},
+	protobuf.RequestType_PubSubNumPat:              {},
+	protobuf.RequestType_PubSubNumSub:              {},
+	protobuf.RequestType_PubSubShardChannels:       {},
+	protobuf.RequestType_PubSubShardNumSub:         {},
+	protobuf.RequestType_RandomKey:                 {},
+	protobuf.RequestType_SCard:                     {},
+	protobuf.RequestType_SDiff:                     {},
+	protobuf.RequestType_SInter:                    {},
+	protobuf.RequestType_SInterCard:                {},
+	protobuf.RequestType_SIsMember:                 {},
+	protobuf.RequestType_SMIsMember:                {},
+	protobuf.RequestType_SMembers:                  {},
+	protobuf.RequestType_SRandMember:               {},
+	protobuf.RequestType_SScan:                     {},
+	protobuf.RequestType_SUnion:                    {},
+	protobuf.RequestType_Scan:                      {},
+	protobuf.RequestType_ScriptExists:              {},
+	protobuf.RequestType_SortReadOnly:              {},
+	protobuf.RequestType_Strlen:                    {},
+	protobuf.RequestType_TTL:                       {},
+	protobuf.RequestType_Time:                      {},
+	protobuf.RequestType_Type:                      {},
+	protobuf.RequestType_XInfoConsumers:            {},
+	protobuf.RequestType_XInfoGroups:               {},
+	protobuf.RequestType_XInfoStream:               {},
+	protobuf.RequestType_XLen:                      {},
+	protobuf.RequestType_XPending:                  {},
+	protobuf.RequestType_XRange:                    {},
+	protobuf.RequestType_XRevRange:                 {},
+	protobuf.RequestType_ZCard:                     {},
+	protobuf.RequestType_ZCount:                    {},
+	protobuf.RequestType_ZDiff:                     {},
+	protobuf.RequestType_ZInter:                    {},
+	protobuf.RequestType_ZInterCard:                {},
+	protobuf.RequestType_ZLexCount:                 {},
+	protobuf.RequestType_ZMScore:                   {},
+	protobuf.RequestType_ZRandMember:               {},
+	protobuf.RequestType_ZRange:                    {},
+	protobuf.RequestType_ZRank:                     {},
+	protobuf.RequestType_ZRevRank:                  {},
+	protobuf.RequestType_ZScan:                     {},
+	protobuf.RequestType_ZScore:                    {},
+	protobuf.RequestType_ZUnion:                    {},
+}
@@ -0,0 +1,114 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+func TestRetryPolicy_AppliesToReadOnlyCommands(t *testing.T) {
+	policy := NewRetryPolicy(3)
+	ctx := context.Background()
+
+	assert.True(t, policy.appliesTo(ctx, protobuf.RequestType_Get))
+	assert.True(t, policy.appliesTo(ctx, protobuf.RequestType_HGetAll))
+	assert.False(t, policy.appliesTo(ctx, protobuf.RequestType_Incr))
+	assert.False(t, policy.appliesTo(ctx, protobuf.RequestType_LPush))
+	assert.False(t, policy.appliesTo(ctx, protobuf.RequestType_CustomCommand))
+
+	assert.True(t, policy.appliesTo(WithRetry(ctx, true), protobuf.RequestType_Incr))
+	assert.False(t, policy.appliesTo(WithRetry(ctx, false), protobuf.RequestType_Get))
+	assert.False(t, NewRetryPolicy(1).appliesTo(WithRetry(ctx, true), protobuf.RequestType_Get))
+}
+
+func TestRetryPolicy_IsRetryable(t *testing.T) {
+	policy := NewRetryPolicy(3)
+	assert.True(t, policy.isRetryable(errors.NewTimeoutError("timeout")))
+	assert.True(t, policy.isRetryable(errors.NewDisconnectError("disconnected")))
+	assert.True(t, policy.isRetryable(&errors.ConnectionError{Msg: "failed"}))
+	assert.False(t, policy.isRetryable(errors.GoError(0, "TRYAGAIN: Multiple keys request during rehashing of slot")))
+	assert.False(t, policy.isRetryable(&errors.RequestError{Msg: "ERR value is not an integer or out of range"}))
+	assert.False(t, policy.isRetryable(&errors.ClosingError{Msg: "closed"}))
+
+	policy.WithRetryableErrors(RetryOnTryAgain)
+	assert.False(t, policy.isRetryable(errors.NewTimeoutError("timeout")))
+	assert.True(t, policy.isRetryable(errors.GoError(0, "TRYAGAIN: Multiple keys request during rehashing of slot")))
+	assert.True(t, policy.isRetryable(errors.GoError(0, "LOADING: Valkey is loading the dataset in memory")))
+}
+
+func TestRetry_RequestTimeoutIsRetried(t *testing.T) {
+	policy := NewRetryPolicy(3).WithBackoff(time.Millisecond, time.Millisecond)
+	attempts := 0
+	value, err := retry(context.Background(), policy, func() (int, error) {
+		attempts++
+		if attempts < 3 {
+			return 0, errors.NewTimeoutError("Request timed out")
+		}
+		return 42, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 42, value)
+	assert.Equal(t, 3, attempts)
+
+	_, err = retry(context.Background(), policy, func() (int, error) {
+		return 0, errors.NewTimeoutError("Request timed out")
+	})
+	assert.Equal(t, &errors.RetryError{Attempts: 3, Err: errors.NewTimeoutError("Request timed out")}, err)
+}
+
+func TestRetry_CallerDeadlineIsNotRetried(t *testing.T) {
+	policy := NewRetryPolicy(3).WithBackoff(time.Millisecond, time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	<-ctx.Done()
+
+	attempts := 0
+	_, err := retry(ctx, policy, func() (int, error) {
+		attempts++
+		return 0, contextError(ctx)
+	})
+	assert.Equal(t, 1, attempts)
+	assert.IsType(t, &errors.TimeoutError{}, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// A deadline reached during the backoff returns the error of the last attempt.
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	policy.WithBackoff(time.Hour, time.Hour)
+	attempts = 0
+	_, err = retry(ctx, policy, func() (int, error) {
+		attempts++
+		return 0, errors.NewTimeoutError("Request timed out")
+	})
+	assert.Equal(t, 1, attempts)
+	assert.Equal(t, errors.NewTimeoutError("Request timed out"), err)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := NewRetryPolicy(10).WithBackoff(10*time.Millisecond, 50*time.Millisecond)
+	assert.Equal(t, 10*time.Millisecond, policy.backoff(1))
+	assert.Equal(t, 20*time.Millisecond, policy.backoff(2))
+	assert.Equal(t, 40*time.Millisecond, policy.backoff(3))
+	assert.Equal(t, 50*time.Millisecond, policy.backoff(4))
+	assert.Equal(t, 50*time.Millisecond, policy.backoff(9))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, NewRetryPolicy(2).WithBackoff(time.Hour, time.Hour).wait(ctx, 1), context.Canceled)
+}
+
+func TestRetryPolicy_Validate(t *testing.T) {
+	assert.NoError(t, NewRetryPolicy(1).validate())
+	assert.IsType(t, &errors.ConfigurationError{}, NewRetryPolicy(0).validate())
+	assert.IsType(t, &errors.ConfigurationError{}, NewRetryPolicy(3).WithBackoff(time.Second, time.Millisecond).validate())
+
+	config := NewGlideClientConfiguration().WithRetryPolicy(NewRetryPolicy(0))
+	_, err := config.toProtobuf()
+	assert.IsType(t, &errors.ConfigurationError{}, err)
+}
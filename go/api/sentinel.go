@@ -0,0 +1,440 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	goErrors "errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+// DefaultSentinelPort is the port of a sentinel whose address doesn't specify one.
+const DefaultSentinelPort = 26379
+
+const (
+	// sentinelTimeout bounds the time taken to connect to a sentinel, and to receive the reply to a query.
+	sentinelTimeout = 5 * time.Second
+	// sentinelRetryInterval is the time to wait before subscribing to the next sentinel, once a subscription failed.
+	sentinelRetryInterval = time.Second
+	// sentinelPingInterval is the time without messages after which a subscribed sentinel is pinged, to detect a broken
+	// connection.
+	sentinelPingInterval = 10 * time.Second
+	// retiredCoreDelay is the time given to the commands sent to the nodes before a failover to complete, before their
+	// connections are closed.
+	retiredCoreDelay = 10 * time.Second
+	// switchMasterChannel is the channel on which the sentinels announce that a replica was promoted.
+	switchMasterChannel = "+switch-master"
+)
+
+// SentinelConfig configures a [GlideClient] to discover its nodes from Valkey Sentinel, instead of connecting to fixed
+// addresses. The client asks the sentinels for the primary of the master, and for its replicas when [ReadFrom] allows
+// reading from replicas. It then subscribes to the failovers announced by the sentinels, and connects to the promoted
+// replica when the primary changes.
+//
+// For example:
+//
+//	sentinel := api.NewSentinelConfig("mymaster").
+//	    WithSentinel(&api.NodeAddress{Host: "sentinel-1", Port: 26379}).
+//	    WithSentinel(&api.NodeAddress{Host: "sentinel-2", Port: 26379})
+//	config := api.NewGlideClientConfiguration().WithSentinel(sentinel).WithReadFrom(api.PreferReplica)
+type SentinelConfig struct {
+	masterName  string
+	sentinels   []NodeAddress
+	credentials *ServerCredentials
+	useTLS      bool
+	// state holds the nodes discovered for a client. It's set on the copy of the configuration made by the client.
+	state *sentinelState
+}
+
+// NewSentinelConfig returns a [SentinelConfig] for the master with the given name, as configured in the sentinels. Add the
+// addresses of the sentinels with [SentinelConfig.WithSentinel].
+func NewSentinelConfig(masterName string) *SentinelConfig {
+	return &SentinelConfig{masterName: masterName}
+}
+
+// WithSentinel adds the address of a sentinel monitoring the master. WithSentinel can be called multiple times to add
+// multiple sentinels, which are queried in turn until one of them answers. A port of 0 means [DefaultSentinelPort].
+func (config *SentinelConfig) WithSentinel(address *NodeAddress) *SentinelConfig {
+	config.sentinels = append(config.sentinels, *address)
+	return config
+}
+
+// WithCredentials sets the credentials used to authenticate to the sentinels. The credentials used to authenticate to the
+// nodes are set with [GlideClientConfiguration.WithCredentials].
+func (config *SentinelConfig) WithCredentials(credentials *ServerCredentials) *SentinelConfig {
+	config.credentials = credentials
+	return config
+}
+
+// WithUseTLS sets whether the connections to the sentinels use TLS. Whether the connections to the nodes use TLS is set with
+// [GlideClientConfiguration.WithUseTLS].
+func (config *SentinelConfig) WithUseTLS(useTLS bool) *SentinelConfig {
+	config.useTLS = useTLS
+	return config
+}
+
+func (config *SentinelConfig) validate() error {
+	if config.masterName == "" {
+		return &errors.ConfigurationError{Msg: "the master name of the sentinel configuration is empty"}
+	}
+	if len(config.sentinels) == 0 {
+		return &errors.ConfigurationError{Msg: "the sentinel configuration has no sentinel address"}
+	}
+	return nil
+}
+
+// sentinelTopology holds the nodes of a master, as reported by a sentinel.
+type sentinelTopology struct {
+	primary  NodeAddress
+	replicas []NodeAddress
+}
+
+// addresses returns the addresses of the nodes a client reading from readFrom connects to: the primary, followed by the
+// replicas unless only the primary is read from.
+func (topology sentinelTopology) addresses(readFrom ReadFrom) []NodeAddress {
+	if readFrom == Primary {
+		return []NodeAddress{topology.primary}
+	}
+	return append([]NodeAddress{topology.primary}, topology.replicas...)
+}
+
+// sentinelState holds the nodes discovered by a client, which the connections of the client established afterward connect
+// to.
+type sentinelState struct {
+	mu       sync.Mutex
+	topology sentinelTopology
+}
+
+func (state *sentinelState) get() sentinelTopology {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	return state.topology
+}
+
+func (state *sentinelState) set(topology sentinelTopology) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.topology = topology
+}
+
+// discover asks the sentinels in turn for the nodes of the master, until one of them answers.
+func (config *SentinelConfig) discover(ctx context.Context) (sentinelTopology, error) {
+	var errs []error
+	for _, address := range config.sentinels {
+		topology, err := config.query(ctx, address)
+		if err == nil {
+			return topology, nil
+		}
+		errs = append(errs, fmt.Errorf("sentinel %s:%d: %w", address.Host, address.Port, err))
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	err := goErrors.Join(errs...)
+	return sentinelTopology{}, &errors.ConnectionError{
+		Msg:   fmt.Sprintf("failed to discover the nodes of master %q from the sentinels: %s", config.masterName, err),
+		Cause: err,
+	}
+}
+
+// query asks the sentinel at address for the nodes of the master.
+func (config *SentinelConfig) query(ctx context.Context, address NodeAddress) (sentinelTopology, error) {
+	conn, err := dialSentinel(ctx, address, config.useTLS, config.credentials)
+	if err != nil {
+		return sentinelTopology{}, err
+	}
+	defer conn.Close()
+	reply, err := conn.do(ctx, "SENTINEL", "GET-MASTER-ADDR-BY-NAME", config.masterName)
+	if err != nil {
+		return sentinelTopology{}, err
+	}
+	primary, err := parseSentinelPrimary(reply)
+	if err != nil {
+		return sentinelTopology{}, err
+	}
+	reply, err = conn.do(ctx, "SENTINEL", "REPLICAS", config.masterName)
+	if err != nil {
+		return sentinelTopology{}, err
+	}
+	replicas, err := parseSentinelReplicas(reply)
+	if err != nil {
+		return sentinelTopology{}, err
+	}
+	return sentinelTopology{primary: primary, replicas: replicas}, nil
+}
+
+// parseSentinelPrimary parses the reply to SENTINEL GET-MASTER-ADDR-BY-NAME.
+func parseSentinelPrimary(reply any) (NodeAddress, error) {
+	if reply == nil {
+		return NodeAddress{}, goErrors.New("unknown master")
+	}
+	fields, ok := reply.([]any)
+	if !ok || len(fields) != 2 {
+		return NodeAddress{}, fmt.Errorf("unexpected reply to SENTINEL GET-MASTER-ADDR-BY-NAME: %v", reply)
+	}
+	host, _ := fields[0].(string)
+	port, _ := fields[1].(string)
+	return parseSentinelAddress(host, port)
+}
+
+// parseSentinelReplicas parses the reply to SENTINEL REPLICAS, skipping the replicas which the sentinel considers down or
+// disconnected.
+func parseSentinelReplicas(reply any) ([]NodeAddress, error) {
+	entries, ok := reply.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected reply to SENTINEL REPLICAS: %v", reply)
+	}
+	replicas := make([]NodeAddress, 0, len(entries))
+	for _, entry := range entries {
+		fields, ok := entry.([]any)
+		if !ok || len(fields)%2 != 0 {
+			return nil, fmt.Errorf("unexpected replica in the reply to SENTINEL REPLICAS: %v", entry)
+		}
+		properties := make(map[string]string, len(fields)/2)
+		for i := 0; i < len(fields); i += 2 {
+			key, _ := fields[i].(string)
+			value, _ := fields[i+1].(string)
+			properties[key] = value
+		}
+		if !replicaAvailable(properties["flags"]) {
+			continue
+		}
+		address, err := parseSentinelAddress(properties["ip"], properties["port"])
+		if err != nil {
+			return nil, err
+		}
+		replicas = append(replicas, address)
+	}
+	return replicas, nil
+}
+
+// replicaAvailable reports whether a replica with the given sentinel flags can serve reads.
+func replicaAvailable(flags string) bool {
+	for _, flag := range strings.Split(flags, ",") {
+		switch flag {
+		case "s_down", "o_down", "disconnected":
+			return false
+		}
+	}
+	return true
+}
+
+func parseSentinelAddress(host string, port string) (NodeAddress, error) {
+	portNumber, err := strconv.Atoi(port)
+	if host == "" || err != nil {
+		return NodeAddress{}, fmt.Errorf("invalid node address %q", net.JoinHostPort(host, port))
+	}
+	return NodeAddress{Host: host, Port: portNumber}, nil
+}
+
+// parseSwitchMaster parses a message published on switchMasterChannel, of the form
+// "<master name> <old ip> <old port> <new ip> <new port>".
+func parseSwitchMaster(message string) (string, NodeAddress, bool) {
+	fields := strings.Fields(message)
+	if len(fields) != 5 {
+		return "", NodeAddress{}, false
+	}
+	primary, err := parseSentinelAddress(fields[3], fields[4])
+	if err != nil {
+		return "", NodeAddress{}, false
+	}
+	return fields[0], primary, true
+}
+
+// sentinelWatcher keeps a client connected to the nodes of its master, following the failovers announced by the sentinels.
+type sentinelWatcher struct {
+	config   *SentinelConfig
+	readFrom ReadFrom
+	closed   <-chan struct{}
+	// repoint connects the client to the nodes of the state of config.
+	repoint func() error
+	// applied holds the addresses the client is connected to.
+	applied []NodeAddress
+}
+
+func newSentinelWatcher(client *baseClient, config *GlideClientConfiguration) *sentinelWatcher {
+	return &sentinelWatcher{
+		config:   config.sentinel,
+		readFrom: config.readFrom,
+		closed:   client.closed,
+		repoint:  func() error { return client.repoint(client.followDatabase(config)) },
+		applied:  config.sentinel.state.get().addresses(config.readFrom),
+	}
+}
+
+// run subscribes to the failovers announced by the sentinels, trying them in turn, until the client is closed.
+func (watcher *sentinelWatcher) run() {
+	for next := 0; ; next = (next + 1) % len(watcher.config.sentinels) {
+		_ = watcher.watch(watcher.config.sentinels[next])
+		timer := time.NewTimer(sentinelRetryInterval)
+		select {
+		case <-watcher.closed:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// watch subscribes to the failovers announced by the sentinel at address, until the connection fails or the client is
+// closed. The nodes are discovered again once subscribed, so that the failovers happening while the watcher wasn't
+// subscribed aren't missed.
+func (watcher *sentinelWatcher) watch(address NodeAddress) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-watcher.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	conn, err := dialSentinel(ctx, address, watcher.config.useTLS, watcher.config.credentials)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// Closing the connection interrupts the wait for a message when the client is closed.
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	defer stop()
+
+	if _, err := conn.do(ctx, "SUBSCRIBE", switchMasterChannel); err != nil {
+		return err
+	}
+	topology, err := watcher.config.discover(ctx)
+	if err != nil {
+		return err
+	}
+	if err := watcher.update(topology); err != nil {
+		return err
+	}
+
+	pinged := false
+	for {
+		reply, err := conn.receive(time.Now().Add(sentinelPingInterval))
+		var netErr net.Error
+		if goErrors.As(err, &netErr) && netErr.Timeout() && !pinged {
+			if err := conn.send(time.Now().Add(sentinelTimeout), "PING"); err != nil {
+				return err
+			}
+			pinged = true
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		pinged = false
+		message, ok := reply.([]any)
+		if !ok || len(message) != 3 || message[0] != "message" || message[1] != switchMasterChannel {
+			continue
+		}
+		payload, _ := message[2].(string)
+		name, primary, ok := parseSwitchMaster(payload)
+		if !ok || name != watcher.config.masterName {
+			continue
+		}
+		topology, err := watcher.config.discover(ctx)
+		if err != nil || topology.primary != primary {
+			// The replicas are discovered again on the next subscription, or failover.
+			topology = sentinelTopology{primary: primary}
+		}
+		if err := watcher.update(topology); err != nil {
+			return err
+		}
+	}
+}
+
+// update connects the client to the nodes of topology, if they differ from the nodes it's connected to.
+func (watcher *sentinelWatcher) update(topology sentinelTopology) error {
+	addresses := topology.addresses(watcher.readFrom)
+	if slices.Equal(addresses, watcher.applied) {
+		return nil
+	}
+	watcher.config.state.set(topology)
+	if err := watcher.repoint(); err != nil {
+		return err
+	}
+	watcher.applied = addresses
+	return nil
+}
+
+// discoverSentinelNodes returns a copy of config connecting to the nodes of its master, discovered from the sentinels.
+func (config *GlideClientConfiguration) discoverSentinelNodes(ctx context.Context) (*GlideClientConfiguration, error) {
+	if err := config.validateSentinel(); err != nil {
+		return nil, err
+	}
+	topology, err := config.sentinel.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The nodes are specific to the client, which follows the failovers on its own.
+	sentinel := *config.sentinel
+	sentinel.state = &sentinelState{topology: topology}
+	discovered := *config
+	discovered.sentinel = &sentinel
+	return &discovered, nil
+}
+
+func (config *GlideClientConfiguration) validateSentinel() error {
+	if len(config.addresses) > 0 {
+		return &errors.ConfigurationError{Msg: "addresses can't be configured along with a sentinel configuration"}
+	}
+	return config.sentinel.validate()
+}
+
+// repoint connects the client and its separate connections to the nodes configured by config, after a failover. The new
+// commands are sent to the new nodes right away, while the commands already sent complete on the previous connections,
+// which are closed after retiredCoreDelay.
+func (client *baseClient) repoint(config clientConfiguration) error {
+	previous, err := client.replaceCore(config)
+	if err != nil {
+		return err
+	}
+	retired := []func(){func() { client.retireCore(previous) }}
+	// The fire-and-forget commands sent from now on are tracked in a new batch.
+	batches := client.fireAndForget.detach()
+
+	var errs []error
+	if client.connections != nil {
+		for _, connection := range client.connections.extra {
+			previous, err := connection.replaceCore(client.connections.config)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			retired = append(retired, func() { connection.retireCore(previous) })
+		}
+	}
+	client.unblockMu.Lock()
+	unblockClient := client.unblockClient
+	client.unblockClient = nil
+	client.unblockMu.Unlock()
+	if unblockClient != nil {
+		unblockClient.Close()
+	}
+	if client.dedicated != nil {
+		client.dedicated.reset()
+	}
+
+	go func() {
+		timer := time.NewTimer(retiredCoreDelay)
+		select {
+		case <-client.closed:
+			timer.Stop()
+		case <-timer.C:
+		}
+		for _, retire := range retired {
+			retire()
+		}
+		client.fireAndForget.abandon(batches)
+	}()
+	return goErrors.Join(errs...)
+}
@@ -0,0 +1,193 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sentinelReplyError is an error reply sent by a sentinel.
+type sentinelReplyError struct {
+	msg string
+}
+
+func (e *sentinelReplyError) Error() string { return e.msg }
+
+// sentinelConn is a minimal RESP2 connection to a sentinel. The core only connects to data nodes, so the queries and the
+// subscriptions used to discover the primary are sent on connections of their own.
+type sentinelConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+// dialSentinel connects to the sentinel at address, and authenticates the connection with credentials, if set.
+func dialSentinel(
+	ctx context.Context,
+	address NodeAddress,
+	useTLS bool,
+	credentials *ServerCredentials,
+) (*sentinelConn, error) {
+	host := address.Host
+	if host == "" {
+		host = DefaultHost
+	}
+	port := address.Port
+	if port == 0 {
+		port = DefaultSentinelPort
+	}
+	dialer := &net.Dialer{Timeout: sentinelTimeout}
+	target := net.JoinHostPort(host, strconv.Itoa(port))
+	var conn net.Conn
+	var err error
+	if useTLS {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: host}}
+		conn, err = tlsDialer.DialContext(ctx, "tcp", target)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", target)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sentinel := &sentinelConn{conn: conn, reader: bufio.NewReader(conn)}
+	if err := sentinel.authenticate(ctx, credentials); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return sentinel, nil
+}
+
+func (c *sentinelConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *sentinelConn) authenticate(ctx context.Context, credentials *ServerCredentials) error {
+	if credentials == nil {
+		return nil
+	}
+	username, password := credentials.username, credentials.password
+	if credentials.provider != nil {
+		current, err := credentials.provider.Credentials(ctx)
+		if err != nil {
+			return err
+		}
+		username, password = current.Username, current.Password
+	}
+	args := []string{"AUTH", password}
+	if username != "" {
+		args = []string{"AUTH", username, password}
+	}
+	_, err := c.do(ctx, args...)
+	return err
+}
+
+// do sends a command and reads its reply, which is one of string, int64, []any or nil. An error reply is returned as a
+// *sentinelReplyError.
+func (c *sentinelConn) do(ctx context.Context, args ...string) (any, error) {
+	deadline := time.Now().Add(sentinelTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	if err := c.send(deadline, args...); err != nil {
+		return nil, err
+	}
+	reply, err := c.receive(deadline)
+	if err != nil {
+		return nil, err
+	}
+	if replyErr, ok := reply.(*sentinelReplyError); ok {
+		return nil, replyErr
+	}
+	return reply, nil
+}
+
+// send writes a command, without waiting for its reply.
+func (c *sentinelConn) send(deadline time.Time, args ...string) error {
+	if err := c.conn.SetWriteDeadline(deadline); err != nil {
+		return err
+	}
+	_, err := c.conn.Write(encodeRESPCommand(args))
+	return err
+}
+
+// receive reads the next reply or pushed message, waiting until deadline.
+func (c *sentinelConn) receive(deadline time.Time) (any, error) {
+	if err := c.conn.SetReadDeadline(deadline); err != nil {
+		return nil, err
+	}
+	return readRESPReply(c.reader)
+}
+
+func encodeRESPCommand(args []string) []byte {
+	var builder strings.Builder
+	builder.WriteString("*" + strconv.Itoa(len(args)) + "\r\n")
+	for _, arg := range args {
+		builder.WriteString("$" + strconv.Itoa(len(arg)) + "\r\n")
+		builder.WriteString(arg)
+		builder.WriteString("\r\n")
+	}
+	return []byte(builder.String())
+}
+
+func readRESPLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasSuffix(line, "\r\n") || len(line) < 3 {
+		return "", fmt.Errorf("malformed RESP line: %q", line)
+	}
+	return line[:len(line)-2], nil
+}
+
+func readRESPReply(reader *bufio.Reader) (any, error) {
+	line, err := readRESPLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return &sentinelReplyError{msg: line[1:]}, nil
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		length, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if length < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, length+2)
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:length]), nil
+	case '*':
+		count, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if count < 0 {
+			return nil, nil
+		}
+		items := make([]any, count)
+		for i := range items {
+			if items[i], err = readRESPReply(reader); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unsupported RESP type: %q", line)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+// fakeSentinel serves the replies of replies to the commands received on a local port, by command name and subcommand.
+func fakeSentinel(t *testing.T, replies map[string]any) NodeAddress {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				for {
+					command, err := readRESPReply(reader)
+					if err != nil {
+						return
+					}
+					args := command.([]any)
+					name := strings.ToUpper(args[0].(string))
+					if len(args) > 1 && name == "SENTINEL" {
+						name += " " + strings.ToUpper(args[1].(string))
+					}
+					reply, ok := replies[name]
+					if !ok {
+						reply = &sentinelReplyError{msg: "ERR unknown command " + name}
+					}
+					if _, err := conn.Write(encodeRESPReply(reply)); err != nil {
+						return
+					}
+				}
+			}()
+		}
+	}()
+	address := listener.Addr().(*net.TCPAddr)
+	return NodeAddress{Host: "127.0.0.1", Port: address.Port}
+}
+
+func encodeRESPReply(reply any) []byte {
+	switch reply := reply.(type) {
+	case nil:
+		return []byte("*-1\r\n")
+	case string:
+		return []byte(fmt.Sprintf("$%d\r\n%s\r\n", len(reply), reply))
+	case *sentinelReplyError:
+		return []byte("-" + reply.msg + "\r\n")
+	case []any:
+		encoded := []byte(fmt.Sprintf("*%d\r\n", len(reply)))
+		for _, item := range reply {
+			encoded = append(encoded, encodeRESPReply(item)...)
+		}
+		return encoded
+	default:
+		panic(fmt.Sprintf("unsupported reply %v", reply))
+	}
+}
+
+func sentinelReplica(ip string, port string, flags string) []any {
+	return []any{"name", ip + ":" + port, "ip", ip, "port", port, "flags", flags}
+}
+
+func TestSentinelConfig_Discover(t *testing.T) {
+	unknown := fakeSentinel(t, map[string]any{"SENTINEL GET-MASTER-ADDR-BY-NAME": nil})
+	sentinel := fakeSentinel(t, map[string]any{
+		"SENTINEL GET-MASTER-ADDR-BY-NAME": []any{"10.0.0.1", "6379"},
+		"SENTINEL REPLICAS": []any{
+			sentinelReplica("10.0.0.2", "6379", "slave"),
+			sentinelReplica("10.0.0.3", "6379", "s_down,slave"),
+			sentinelReplica("10.0.0.4", "6379", "slave,disconnected"),
+			sentinelReplica("10.0.0.5", "6380", "slave"),
+		},
+	})
+
+	// The sentinels are queried in turn until one of them knows the master.
+	config := NewSentinelConfig("mymaster").WithSentinel(&unknown).WithSentinel(&sentinel)
+	topology, err := config.discover(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, NodeAddress{Host: "10.0.0.1", Port: 6379}, topology.primary)
+	assert.Equal(t, []NodeAddress{{Host: "10.0.0.2", Port: 6379}, {Host: "10.0.0.5", Port: 6380}}, topology.replicas)
+
+	_, err = NewSentinelConfig("mymaster").WithSentinel(&unknown).discover(context.Background())
+	assert.ErrorAs(t, err, new(*errors.ConnectionError))
+	assert.ErrorContains(t, err, "unknown master")
+}
+
+func TestSentinelConfig_Credentials(t *testing.T) {
+	sentinel := fakeSentinel(t, map[string]any{
+		"AUTH":                             &sentinelReplyError{msg: "WRONGPASS invalid username-password pair"},
+		"SENTINEL GET-MASTER-ADDR-BY-NAME": []any{"10.0.0.1", "6379"},
+	})
+	config := NewSentinelConfig("mymaster").WithSentinel(&sentinel).WithCredentials(NewServerCredentials("user", "wrong"))
+	_, err := config.discover(context.Background())
+	assert.ErrorContains(t, err, "WRONGPASS")
+}
+
+func TestSentinelTopology_Addresses(t *testing.T) {
+	topology := sentinelTopology{
+		primary:  NodeAddress{Host: "10.0.0.1", Port: 6379},
+		replicas: []NodeAddress{{Host: "10.0.0.2", Port: 6379}},
+	}
+	assert.Equal(t, []NodeAddress{topology.primary}, topology.addresses(Primary))
+	assert.Equal(t, []NodeAddress{topology.primary, topology.replicas[0]}, topology.addresses(PreferReplica))
+}
+
+func TestParseSwitchMaster(t *testing.T) {
+	name, primary, ok := parseSwitchMaster("mymaster 10.0.0.1 6379 10.0.0.2 6380")
+	assert.True(t, ok)
+	assert.Equal(t, "mymaster", name)
+	assert.Equal(t, NodeAddress{Host: "10.0.0.2", Port: 6380}, primary)
+
+	_, _, ok = parseSwitchMaster("mymaster 10.0.0.1 6379")
+	assert.False(t, ok)
+	_, _, ok = parseSwitchMaster("mymaster 10.0.0.1 6379 10.0.0.2 port")
+	assert.False(t, ok)
+}
+
+func TestGlideClientConfiguration_Sentinel(t *testing.T) {
+	sentinel := NewSentinelConfig("mymaster").WithSentinel(&NodeAddress{Host: "sentinel"})
+
+	config := NewGlideClientConfiguration().WithSentinel(sentinel).WithAddress(&NodeAddress{Host: "primary"})
+	_, err := config.toProtobuf()
+	assert.ErrorAs(t, err, new(*errors.ConfigurationError))
+
+	_, err = NewGlideClientConfiguration().WithSentinel(NewSentinelConfig("mymaster")).toProtobuf()
+	assert.ErrorAs(t, err, new(*errors.ConfigurationError))
+
+	// The addresses are those discovered for the client.
+	config = NewGlideClientConfiguration().WithSentinel(sentinel).WithReadFrom(PreferReplica)
+	discovered := *config
+	discoveredSentinel := *sentinel
+	discoveredSentinel.state = &sentinelState{topology: sentinelTopology{
+		primary:  NodeAddress{Host: "10.0.0.1", Port: 6379},
+		replicas: []NodeAddress{{Host: "10.0.0.2", Port: 6379}},
+	}}
+	discovered.sentinel = &discoveredSentinel
+	request, err := discovered.toProtobuf()
+	require.NoError(t, err)
+	require.Len(t, request.Addresses, 2)
+	assert.Equal(t, "10.0.0.1", request.Addresses[0].Host)
+	assert.Equal(t, "10.0.0.2", request.Addresses[1].Host)
+	assert.Nil(t, sentinel.state)
+}
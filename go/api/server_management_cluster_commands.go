@@ -14,6 +14,10 @@ import (
 //
 // [valkey.io]: https://valkey.io/commands/#server
 type ServerManagementClusterCommands interface {
+	Select(ctx context.Context, index int64) (string, error)
+
+	SwapDB(ctx context.Context, index1 int64, index2 int64) (string, error)
+
 	Info(ctx context.Context) (map[string]string, error)
 
 	InfoWithOptions(ctx context.Context, options options.ClusterInfoOptions) (ClusterValue[string], error)
@@ -57,4 +61,60 @@ type ServerManagementClusterCommands interface {
 	ConfigRewrite(ctx context.Context) (string, error)
 
 	ConfigRewriteWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
+
+	SlowLogGet(ctx context.Context) (ClusterValue[[]SlowLogEntry], error)
+
+	SlowLogGetWithOptions(ctx context.Context, opts options.ClusterSlowLogOptions) (ClusterValue[[]SlowLogEntry], error)
+
+	SlowLogLen(ctx context.Context) (ClusterValue[int64], error)
+
+	SlowLogLenWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[int64], error)
+
+	SlowLogReset(ctx context.Context) (string, error)
+
+	SlowLogResetWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
+
+	LatencyLatest(ctx context.Context) (ClusterValue[[]LatencyEvent], error)
+
+	LatencyLatestWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[[]LatencyEvent], error)
+
+	LatencyHistory(ctx context.Context, event string) (ClusterValue[[]LatencySample], error)
+
+	LatencyHistoryWithOptions(
+		ctx context.Context,
+		event string,
+		routeOption options.RouteOption,
+	) (ClusterValue[[]LatencySample], error)
+
+	LatencyDoctor(ctx context.Context) (ClusterValue[string], error)
+
+	LatencyDoctorWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[string], error)
+
+	LatencyReset(ctx context.Context, events []string) (int64, error)
+
+	LatencyResetWithOptions(ctx context.Context, events []string, routeOption options.RouteOption) (int64, error)
+
+	LatencyHistogram(ctx context.Context, commands []string) (ClusterValue[map[string]CommandHistogram], error)
+
+	LatencyHistogramWithOptions(
+		ctx context.Context,
+		commands []string,
+		routeOption options.RouteOption,
+	) (ClusterValue[map[string]CommandHistogram], error)
+
+	MemoryStats(ctx context.Context) (ClusterValue[MemoryStats], error)
+
+	MemoryStatsWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[MemoryStats], error)
+
+	MemoryDoctor(ctx context.Context) (ClusterValue[string], error)
+
+	MemoryDoctorWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[string], error)
+
+	MemoryMallocStats(ctx context.Context) (ClusterValue[string], error)
+
+	MemoryMallocStatsWithOptions(ctx context.Context, routeOption options.RouteOption) (ClusterValue[string], error)
+
+	MemoryPurge(ctx context.Context) (string, error)
+
+	MemoryPurgeWithOptions(ctx context.Context, routeOption options.RouteOption) (string, error)
 }
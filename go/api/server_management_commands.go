@@ -45,4 +45,30 @@ type ServerManagementCommands interface {
 	ConfigResetStat(ctx context.Context) (string, error)
 
 	ConfigRewrite(ctx context.Context) (string, error)
+
+	SlowLogGet(ctx context.Context) ([]SlowLogEntry, error)
+
+	SlowLogGetWithOptions(ctx context.Context, opts options.SlowLogOptions) ([]SlowLogEntry, error)
+
+	SlowLogLen(ctx context.Context) (int64, error)
+
+	SlowLogReset(ctx context.Context) (string, error)
+
+	LatencyLatest(ctx context.Context) ([]LatencyEvent, error)
+
+	LatencyHistory(ctx context.Context, event string) ([]LatencySample, error)
+
+	LatencyDoctor(ctx context.Context) (string, error)
+
+	LatencyReset(ctx context.Context, events []string) (int64, error)
+
+	LatencyHistogram(ctx context.Context, commands []string) (map[string]CommandHistogram, error)
+
+	MemoryStats(ctx context.Context) (MemoryStats, error)
+
+	MemoryDoctor(ctx context.Context) (string, error)
+
+	MemoryMallocStats(ctx context.Context) (string, error)
+
+	MemoryPurge(ctx context.Context) (string, error)
 }
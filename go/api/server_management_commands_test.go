@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/valkey-io/valkey-glide/go/api/options"
 )
 
@@ -262,3 +265,103 @@ func ExampleGlideClient_ConfigRewrite() {
 	// Output:
 	// OK
 }
+
+func TestConvertSlowLog(t *testing.T) {
+	entries, err := convertSlowLog([]interface{}{
+		[]interface{}{int64(2), int64(1700000000), int64(15000), []interface{}{"KEYS", "*"}, "127.0.0.1:50000", "worker"},
+		[]interface{}{int64(1), int64(1699999999), int64(12000), []interface{}{"DEBUG", "SLEEP", "0.012"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []SlowLogEntry{
+		{
+			Id:            2,
+			Timestamp:     1700000000,
+			ExecutionTime: 15000,
+			Args:          []string{"KEYS", "*"},
+			ClientAddr:    "127.0.0.1:50000",
+			ClientName:    "worker",
+		},
+		{Id: 1, Timestamp: 1699999999, ExecutionTime: 12000, Args: []string{"DEBUG", "SLEEP", "0.012"}},
+	}, entries)
+
+	entries, err = convertSlowLog(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = convertSlowLog([]interface{}{[]interface{}{int64(1)}})
+	assert.Error(t, err)
+}
+
+func TestConvertLatency(t *testing.T) {
+	events, err := convertLatencyLatest([]interface{}{
+		[]interface{}{"command", int64(1700000000), int64(120), int64(250)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []LatencyEvent{{Name: "command", Timestamp: 1700000000, Latest: 120, Max: 250}}, events)
+
+	samples, err := convertLatencyHistory([]interface{}{
+		[]interface{}{int64(1700000000), int64(250)},
+		[]interface{}{int64(1700000005), int64(120)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []LatencySample{{Timestamp: 1700000000, Latency: 250}, {Timestamp: 1700000005, Latency: 120}}, samples)
+
+	histograms, err := convertLatencyHistogram(map[string]interface{}{
+		"set": map[string]interface{}{
+			"calls":          int64(3),
+			"histogram_usec": map[string]interface{}{"1": int64(1), "4": int64(3)},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]CommandHistogram{"set": {Calls: 3, Buckets: map[int64]int64{1: 1, 4: 3}}}, histograms)
+
+	_, err = convertLatencyHistogram(map[string]interface{}{
+		"set": map[string]interface{}{"histogram_usec": map[string]interface{}{"one": int64(1)}},
+	})
+	assert.Error(t, err)
+}
+
+func TestConvertMemoryStats(t *testing.T) {
+	fields := map[string]interface{}{
+		"peak.allocated":      int64(2000000),
+		"total.allocated":     int64(1000000),
+		"startup.allocated":   int64(800000),
+		"overhead.total":      int64(900000),
+		"keys.count":          int64(10),
+		"dataset.bytes":       int64(100000),
+		"fragmentation":       1.5,
+		"fragmentation.bytes": int64(500000),
+		"db.0":                map[string]interface{}{"overhead.hashtable.main": int64(200)},
+	}
+	stats, err := convertMemoryStats(fields)
+	require.NoError(t, err)
+	assert.Equal(t, MemoryStats{
+		PeakAllocated:      2000000,
+		TotalAllocated:     1000000,
+		StartupAllocated:   800000,
+		OverheadTotal:      900000,
+		KeysCount:          10,
+		DatasetBytes:       100000,
+		Fragmentation:      1.5,
+		FragmentationBytes: 500000,
+		Fields:             fields,
+	}, stats)
+
+	_, err = convertMemoryStats("stats")
+	assert.Error(t, err)
+}
+
+func ExampleGlideClient_SlowLogLen() {
+	var client *GlideClient = getExampleGlideClient() // example helper function
+	_, err := client.SlowLogReset(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	result, err := client.SlowLogLen(context.Background())
+	if err != nil {
+		fmt.Println("Glide example failed with an error: ", err)
+	}
+	fmt.Println(result)
+
+	// Output: 0
+}
@@ -0,0 +1,550 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+// ketamaPointsPerWeight is the number of points placed on the hash ring for every unit of weight of a node. Every MD5 hash
+// of the node name provides 4 points.
+const ketamaPointsPerWeight = 160
+
+// ShardedClient interface compliance check.
+var _ BaseClient = (*ShardedClient)(nil)
+
+// ShardedClientConfiguration configures a [ShardedClient]: the standalone nodes the keys are spread between, with their
+// weight, and the configuration of the [GlideClient] connected to every node.
+type ShardedClientConfiguration struct {
+	nodes      []shardNode
+	nodeConfig *GlideClientConfiguration
+}
+
+type shardNode struct {
+	address NodeAddress
+	weight  int
+}
+
+// NewShardedClientConfiguration returns a [ShardedClientConfiguration] without nodes. For further configuration, use the
+// [ShardedClientConfiguration] With* methods.
+func NewShardedClientConfiguration() *ShardedClientConfiguration {
+	return &ShardedClientConfiguration{}
+}
+
+// WithNode adds a standalone node, whose share of the keys is proportional to its weight. A node with a weight of 2 serves
+// twice as many keys as a node with a weight of 1.
+func (config *ShardedClientConfiguration) WithNode(address *NodeAddress, weight int) *ShardedClientConfiguration {
+	config.nodes = append(config.nodes, shardNode{address: *address, weight: weight})
+	return config
+}
+
+// WithNodeConfiguration sets the configuration of the clients connected to the nodes, such as the credentials or the
+// request timeout. The configuration must not contain addresses, which are set for every node.
+func (config *ShardedClientConfiguration) WithNodeConfiguration(
+	nodeConfig *GlideClientConfiguration,
+) *ShardedClientConfiguration {
+	config.nodeConfig = nodeConfig
+	return config
+}
+
+// ShardedClient spreads keys between independent standalone servers, such as a cache tier, without cluster mode. Every
+// server is served by a [GlideClient], and every key is mapped to a server with a consistent hash ring in the style of
+// ketama, so that adding or removing a server only moves the keys of its share of the ring. Like in cluster mode, only the
+// hash tag of a key is hashed when the key contains one, so that keys such as "{user1}:name" and "{user1}:email" are
+// stored on the same server.
+//
+// MGet, MSet, Del, Unlink, Exists and Touch are split by server, and their results merged. MSet isn't atomic across
+// servers. Other commands with several keys, including scripts, fail unless all their keys are stored on the same server.
+// Commands without keys, such as ScriptFlush or the function commands, aren't supported.
+//
+// For example:
+//
+//	config := api.NewShardedClientConfiguration().
+//	    WithNode(&api.NodeAddress{Host: "cache-1", Port: 6379}, 1).
+//	    WithNode(&api.NodeAddress{Host: "cache-2", Port: 6379}, 2).
+//	    WithNodeConfiguration(api.NewGlideClientConfiguration().WithRequestTimeout(100 * time.Millisecond))
+//	client, err := api.NewShardedClient(ctx, config)
+type ShardedClient struct {
+	*baseClient
+}
+
+// NewShardedClient connects a [GlideClient] to every node of config, and returns a [ShardedClient] spreading the keys
+// between them.
+func NewShardedClient(ctx context.Context, config *ShardedClientConfiguration) (*ShardedClient, error) {
+	if len(config.nodes) == 0 {
+		return nil, &errors.ConfigurationError{Msg: "the sharded client configuration has no node"}
+	}
+	nodeConfig := config.nodeConfig
+	if nodeConfig == nil {
+		nodeConfig = NewGlideClientConfiguration()
+	}
+	if len(nodeConfig.addresses) > 0 || nodeConfig.sentinel != nil {
+		return nil, &errors.ConfigurationError{Msg: "the node configuration of a sharded client must not contain addresses"}
+	}
+	shards := &shardSet{nodeConfig: *nodeConfig, nodes: make(map[string]*ringNode)}
+	shards.ring.Store(&hashRing{})
+	client := &ShardedClient{&baseClient{closed: make(chan struct{}), shards: shards}}
+	shards.closed = client.closed
+	for _, node := range config.nodes {
+		if err := client.AddNode(ctx, &node.address, node.weight); err != nil {
+			client.Close()
+			return nil, err
+		}
+	}
+	return client, nil
+}
+
+// AddNode connects to a new node, and moves to it its share of the keys, proportional to its weight.
+func (client *ShardedClient) AddNode(ctx context.Context, address *NodeAddress, weight int) error {
+	return client.shards.add(ctx, *address, weight)
+}
+
+// RemoveNode removes a node, whose keys are spread between the remaining nodes. The commands already sent to the node
+// complete before its connection is closed.
+func (client *ShardedClient) RemoveNode(address *NodeAddress) error {
+	return client.shards.remove(*address)
+}
+
+// NodeFor returns the address of the node storing key.
+func (client *ShardedClient) NodeFor(key string) (NodeAddress, error) {
+	node, err := client.shards.ring.Load().nodeFor([]string{key})
+	if err != nil {
+		return NodeAddress{}, err
+	}
+	return node.address, nil
+}
+
+// Close closes the clients of all the nodes.
+func (client *ShardedClient) Close() {
+	client.shards.close()
+}
+
+// MGet returns the values of keys, fetched from every node storing some of them.
+//
+// See [StringCommands.MGet].
+func (client *ShardedClient) MGet(ctx context.Context, keys []string) ([]Result[string], error) {
+	values := make([]Result[string], len(keys))
+	err := client.shards.fanOut(ctx, keys, func(ctx context.Context, node *GlideClient, indexes []int) error {
+		nodeValues, err := node.MGet(ctx, keysAt(keys, indexes))
+		if err != nil {
+			return err
+		}
+		for i, index := range indexes {
+			values[index] = nodeValues[i]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// MSet sets the keys of keyValueMap on the nodes storing them. The keys are set atomically on every node, but not across
+// nodes.
+//
+// See [StringCommands.MSet].
+func (client *ShardedClient) MSet(ctx context.Context, keyValueMap map[string]string) (string, error) {
+	keys := make([]string, 0, len(keyValueMap))
+	for key := range keyValueMap {
+		keys = append(keys, key)
+	}
+	err := client.shards.fanOut(ctx, keys, func(ctx context.Context, node *GlideClient, indexes []int) error {
+		nodeValues := make(map[string]string, len(indexes))
+		for _, index := range indexes {
+			nodeValues[keys[index]] = keyValueMap[keys[index]]
+		}
+		_, err := node.MSet(ctx, nodeValues)
+		return err
+	})
+	if err != nil {
+		return DefaultStringResponse, err
+	}
+	return OK, nil
+}
+
+// Del removes keys from the nodes storing them, and returns the number of keys removed.
+//
+// See [GenericBaseCommands.Del].
+func (client *ShardedClient) Del(ctx context.Context, keys []string) (int64, error) {
+	return client.shards.sum(ctx, keys, (*GlideClient).Del)
+}
+
+// Unlink removes keys from the nodes storing them in the background, and returns the number of keys removed.
+//
+// See [GenericBaseCommands.Unlink].
+func (client *ShardedClient) Unlink(ctx context.Context, keys []string) (int64, error) {
+	return client.shards.sum(ctx, keys, (*GlideClient).Unlink)
+}
+
+// Exists returns the number of keys which exist on the nodes storing them.
+//
+// See [GenericBaseCommands.Exists].
+func (client *ShardedClient) Exists(ctx context.Context, keys []string) (int64, error) {
+	return client.shards.sum(ctx, keys, (*GlideClient).Exists)
+}
+
+// Touch updates the last access time of keys on the nodes storing them, and returns the number of keys touched.
+//
+// See [GenericBaseCommands.Touch].
+func (client *ShardedClient) Touch(ctx context.Context, keys []string) (int64, error) {
+	return client.shards.sum(ctx, keys, (*GlideClient).Touch)
+}
+
+func keysAt(keys []string, indexes []int) []string {
+	selected := make([]string, len(indexes))
+	for i, index := range indexes {
+		selected[i] = keys[index]
+	}
+	return selected
+}
+
+// shardSet holds the nodes of a [ShardedClient], and routes the commands of the client to them.
+type shardSet struct {
+	nodeConfig GlideClientConfiguration
+	ring       atomic.Pointer[hashRing]
+	closed     chan struct{}
+
+	mu       sync.Mutex
+	nodes    map[string]*ringNode
+	isClosed bool
+}
+
+type ringNode struct {
+	name    string
+	address NodeAddress
+	weight  int
+	client  *GlideClient
+}
+
+func (shards *shardSet) add(ctx context.Context, address NodeAddress, weight int) error {
+	if weight < 1 {
+		return &errors.ConfigurationError{Msg: fmt.Sprintf("invalid weight %d of node %s", weight, ringNodeName(address))}
+	}
+	nodeConfig := shards.nodeConfig
+	nodeConfig.addresses = []NodeAddress{address}
+	connected, err := NewGlideClient(ctx, &nodeConfig)
+	if err != nil {
+		return err
+	}
+	node := &ringNode{name: ringNodeName(address), address: address, weight: weight, client: connected.(*GlideClient)}
+
+	shards.mu.Lock()
+	defer shards.mu.Unlock()
+	if shards.isClosed {
+		node.client.Close()
+		return &errors.ClosingError{Msg: "AddNode failed. The client is closed."}
+	}
+	if _, ok := shards.nodes[node.name]; ok {
+		node.client.Close()
+		return &errors.RequestError{Msg: fmt.Sprintf("node %s was already added", node.name)}
+	}
+	shards.nodes[node.name] = node
+	shards.ring.Store(newHashRing(shards.nodes))
+	return nil
+}
+
+func (shards *shardSet) remove(address NodeAddress) error {
+	name := ringNodeName(address)
+	shards.mu.Lock()
+	node, ok := shards.nodes[name]
+	if !ok {
+		shards.mu.Unlock()
+		return &errors.RequestError{Msg: fmt.Sprintf("node %s wasn't added", name)}
+	}
+	delete(shards.nodes, name)
+	shards.ring.Store(newHashRing(shards.nodes))
+	shards.mu.Unlock()
+
+	go func() {
+		timer := time.NewTimer(retiredCoreDelay)
+		select {
+		case <-shards.closed:
+			timer.Stop()
+		case <-timer.C:
+		}
+		node.client.Close()
+	}()
+	return nil
+}
+
+func (shards *shardSet) close() {
+	shards.mu.Lock()
+	if shards.isClosed {
+		shards.mu.Unlock()
+		return
+	}
+	shards.isClosed = true
+	nodes := shards.nodes
+	shards.nodes = nil
+	shards.ring.Store(&hashRing{})
+	close(shards.closed)
+	shards.mu.Unlock()
+	for _, node := range nodes {
+		node.client.Close()
+	}
+}
+
+// clients returns the clients of the nodes.
+func (shards *shardSet) clients() []*GlideClient {
+	shards.mu.Lock()
+	defer shards.mu.Unlock()
+	clients := make([]*GlideClient, 0, len(shards.nodes))
+	for _, node := range shards.nodes {
+		clients = append(clients, node.client)
+	}
+	return clients
+}
+
+// shardFor returns the client of the node storing the keys of the command.
+func (shards *shardSet) shardFor(requestType protobuf.RequestType, args []string) (*baseClient, error) {
+	return shards.shardForKeys(commandKeys(requestType, args))
+}
+
+// shardForKeys returns the client of the node storing keys, which must all be stored on the same node.
+func (shards *shardSet) shardForKeys(keys []string) (*baseClient, error) {
+	node, err := shards.ring.Load().nodeFor(keys)
+	if err != nil {
+		return nil, err
+	}
+	return node.client.baseClient, nil
+}
+
+// fanOut calls send concurrently for every node storing some of keys, with the indexes of its keys.
+func (shards *shardSet) fanOut(
+	ctx context.Context,
+	keys []string,
+	send func(ctx context.Context, node *GlideClient, indexes []int) error,
+) error {
+	ring := shards.ring.Load()
+	if len(ring.points) == 0 {
+		return ring.emptyError()
+	}
+	groups := make(map[*ringNode][]int)
+	for i, key := range keys {
+		node := ring.node(key)
+		groups[node] = append(groups[node], i)
+	}
+	if len(groups) == 0 {
+		// The node of a command without keys returns the error of the server.
+		return send(ctx, ring.points[0].node.client, nil)
+	}
+	errs := make(chan error, len(groups))
+	for node, indexes := range groups {
+		go func(node *ringNode, indexes []int) {
+			errs <- send(ctx, node.client, indexes)
+		}(node, indexes)
+	}
+	var firstErr error
+	for range groups {
+		if err := <-errs; err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// sum sends command to every node storing some of keys, and returns the sum of the results.
+func (shards *shardSet) sum(
+	ctx context.Context,
+	keys []string,
+	command func(node *GlideClient, ctx context.Context, keys []string) (int64, error),
+) (int64, error) {
+	var total atomic.Int64
+	err := shards.fanOut(ctx, keys, func(ctx context.Context, node *GlideClient, indexes []int) error {
+		count, err := command(node, ctx, keysAt(keys, indexes))
+		total.Add(count)
+		return err
+	})
+	if err != nil {
+		return defaultIntResponse, err
+	}
+	return total.Load(), nil
+}
+
+// hashRing is an immutable consistent hash ring, mapping every key to a node.
+type hashRing struct {
+	// points are sorted by hash. A key is stored on the node of the first point whose hash is greater or equal to the hash
+	// of the key, wrapping around.
+	points []ringPoint
+}
+
+type ringPoint struct {
+	hash uint32
+	node *ringNode
+}
+
+// newHashRing places ketamaPointsPerWeight points on the ring for every unit of weight of every node, at the hashes of
+// "<host>:<port>-<index>".
+func newHashRing(nodes map[string]*ringNode) *hashRing {
+	ring := &hashRing{}
+	for _, node := range nodes {
+		for i := 0; i < ketamaPointsPerWeight*node.weight/4; i++ {
+			digest := md5.Sum([]byte(node.name + "-" + strconv.Itoa(i)))
+			for j := 0; j < 4; j++ {
+				ring.points = append(ring.points, ringPoint{hash: binary.LittleEndian.Uint32(digest[j*4:]), node: node})
+			}
+		}
+	}
+	sort.Slice(ring.points, func(i, j int) bool {
+		if ring.points[i].hash != ring.points[j].hash {
+			return ring.points[i].hash < ring.points[j].hash
+		}
+		// Colliding points are ordered by node, so that the ring doesn't depend on the iteration order of nodes.
+		return ring.points[i].node.name < ring.points[j].node.name
+	})
+	return ring
+}
+
+// node returns the node storing key. The ring must not be empty.
+func (ring *hashRing) node(key string) *ringNode {
+	hash := ketamaKeyHash(key)
+	index := sort.Search(len(ring.points), func(i int) bool { return ring.points[i].hash >= hash })
+	if index == len(ring.points) {
+		index = 0
+	}
+	return ring.points[index].node
+}
+
+// nodeFor returns the node storing keys, which must all be stored on the same node.
+func (ring *hashRing) nodeFor(keys []string) (*ringNode, error) {
+	if len(ring.points) == 0 {
+		return nil, ring.emptyError()
+	}
+	if len(keys) == 0 {
+		return nil, &errors.RequestError{Msg: "The command has no key, and can't be sent through a sharded client"}
+	}
+	node := ring.node(keys[0])
+	for _, key := range keys[1:] {
+		if ring.node(key) != node {
+			return nil, &errors.RequestError{
+				Msg: "The keys of the command are stored on different nodes, use hash tags to store them on the same node",
+			}
+		}
+	}
+	return node, nil
+}
+
+func (ring *hashRing) emptyError() error {
+	return &errors.ClosingError{Msg: "The sharded client has no node."}
+}
+
+// ketamaKeyHash returns the position of key on the hash ring. Only the hash tag is hashed when the key contains one.
+func ketamaKeyHash(key string) uint32 {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			key = key[start+1 : start+1+end]
+		}
+	}
+	digest := md5.Sum([]byte(key))
+	return binary.LittleEndian.Uint32(digest[:4])
+}
+
+func ringNodeName(address NodeAddress) string {
+	host, port := address.Host, address.Port
+	if host == "" {
+		host = DefaultHost
+	}
+	if port == 0 {
+		port = DefaultPort
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// commandKeys returns the keys of a command, or nil for commands without keys.
+func commandKeys(requestType protobuf.RequestType, args []string) []string {
+	switch requestType {
+	case protobuf.RequestType_Del, protobuf.RequestType_Unlink, protobuf.RequestType_Exists, protobuf.RequestType_Touch,
+		protobuf.RequestType_MGet, protobuf.RequestType_Watch, protobuf.RequestType_PfCount, protobuf.RequestType_PfMerge,
+		protobuf.RequestType_SDiff, protobuf.RequestType_SInter, protobuf.RequestType_SUnion,
+		protobuf.RequestType_SDiffStore, protobuf.RequestType_SInterStore, protobuf.RequestType_SUnionStore:
+		return args
+	case protobuf.RequestType_MSet, protobuf.RequestType_MSetNX:
+		keys := make([]string, 0, len(args)/2)
+		for i := 0; i < len(args); i += 2 {
+			keys = append(keys, args[i])
+		}
+		return keys
+	case protobuf.RequestType_BLPop, protobuf.RequestType_BRPop, protobuf.RequestType_BZPopMin,
+		protobuf.RequestType_BZPopMax:
+		// The keys are followed by the timeout.
+		if len(args) > 1 {
+			return args[:len(args)-1]
+		}
+	case protobuf.RequestType_Rename, protobuf.RequestType_RenameNX, protobuf.RequestType_Copy,
+		protobuf.RequestType_LMove, protobuf.RequestType_BLMove, protobuf.RequestType_RPopLPush,
+		protobuf.RequestType_BRPopLPush, protobuf.RequestType_SMove, protobuf.RequestType_GeoSearchStore,
+		protobuf.RequestType_ZRangeStore, protobuf.RequestType_LCS:
+		if len(args) > 1 {
+			return args[:2]
+		}
+	case protobuf.RequestType_ZDiff, protobuf.RequestType_ZInter, protobuf.RequestType_ZUnion,
+		protobuf.RequestType_ZInterCard, protobuf.RequestType_SInterCard, protobuf.RequestType_LMPop,
+		protobuf.RequestType_ZMPop:
+		return countedKeys(args, 0)
+	case protobuf.RequestType_BLMPop, protobuf.RequestType_BZMPop:
+		// The timeout comes first.
+		return countedKeys(args, 1)
+	case protobuf.RequestType_ZDiffStore, protobuf.RequestType_ZInterStore, protobuf.RequestType_ZUnionStore:
+		// The destination comes first.
+		if len(args) > 0 {
+			return append([]string{args[0]}, countedKeys(args, 1)...)
+		}
+	case protobuf.RequestType_BitOp:
+		// The operation comes first.
+		if len(args) > 1 {
+			return args[1:]
+		}
+	case protobuf.RequestType_Sort:
+		if len(args) > 2 && args[1] == options.StoreKeyword {
+			return []string{args[0], args[2]}
+		}
+		if len(args) > 0 {
+			return args[:1]
+		}
+	case protobuf.RequestType_XRead, protobuf.RequestType_XReadGroup:
+		first := 0
+		if requestType == protobuf.RequestType_XReadGroup {
+			// Skip "GROUP", the group and the consumer, which could be named "STREAMS".
+			first = 3
+		}
+		for i := first; i < len(args); i++ {
+			if args[i] == options.StreamsKeyword {
+				// The keys are followed by as many IDs.
+				streams := args[i+1:]
+				return streams[:len(streams)/2]
+			}
+		}
+	default:
+		if _, ok := firstKeyRequestTypes[requestType]; ok && len(args) > 0 {
+			return args[:1]
+		}
+	}
+	return nil
+}
+
+// countedKeys returns the keys following the number of keys at index count of args.
+func countedKeys(args []string, count int) []string {
+	if count >= len(args) {
+		return nil
+	}
+	n, err := strconv.Atoi(args[count])
+	if err != nil || n < 0 || count+1+n > len(args) {
+		return nil
+	}
+	return args[count+1 : count+1+n]
+}
@@ -0,0 +1,126 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/protobuf"
+)
+
+func newTestRingNodes(weights ...int) map[string]*ringNode {
+	nodes := make(map[string]*ringNode)
+	for i, weight := range weights {
+		address := NodeAddress{Host: "10.0.0." + strconv.Itoa(i+1), Port: 6379}
+		nodes[ringNodeName(address)] = &ringNode{name: ringNodeName(address), address: address, weight: weight}
+	}
+	return nodes
+}
+
+// ringShares returns the number of keys out of count stored on every node of ring.
+func ringShares(ring *hashRing, count int) map[string]int {
+	shares := make(map[string]int)
+	for i := 0; i < count; i++ {
+		shares[ring.node("key:"+strconv.Itoa(i)).name]++
+	}
+	return shares
+}
+
+func TestHashRing_Distribution(t *testing.T) {
+	ring := newHashRing(newTestRingNodes(1, 1, 2))
+	assert.Len(t, ring.points, 4*ketamaPointsPerWeight)
+
+	shares := ringShares(ring, 100000)
+	assert.InDelta(t, 25000, shares["10.0.0.1:6379"], 5000)
+	assert.InDelta(t, 25000, shares["10.0.0.2:6379"], 5000)
+	assert.InDelta(t, 50000, shares["10.0.0.3:6379"], 5000)
+}
+
+func TestHashRing_Stability(t *testing.T) {
+	nodes := newTestRingNodes(1, 1, 1)
+	before := newHashRing(nodes)
+	added := newTestRingNodes(1, 1, 1, 1)
+	after := newHashRing(added)
+
+	// Adding a node only moves keys to the new node.
+	moved := 0
+	for i := 0; i < 10000; i++ {
+		key := "key:" + strconv.Itoa(i)
+		if previous, current := before.node(key).name, after.node(key).name; previous != current {
+			assert.Equal(t, "10.0.0.4:6379", current)
+			moved++
+		}
+	}
+	assert.InDelta(t, 2500, moved, 800)
+
+	// The ring doesn't depend on the order the nodes were added in.
+	assert.Equal(t, ringShares(before, 1000), ringShares(newHashRing(newTestRingNodes(1, 1, 1)), 1000))
+}
+
+func TestHashRing_HashTags(t *testing.T) {
+	ring := newHashRing(newTestRingNodes(1, 1, 1, 1))
+	assert.Equal(t, ketamaKeyHash("user1"), ketamaKeyHash("{user1}:name"))
+
+	node, err := ring.nodeFor([]string{"{user1}:name", "{user1}:email", "prefix{user1}"})
+	require.NoError(t, err)
+	assert.Equal(t, ring.node("user1"), node)
+
+	var other string
+	for i := 0; other == ""; i++ {
+		if key := "key:" + strconv.Itoa(i); ring.node(key) != node {
+			other = key
+		}
+	}
+	_, err = ring.nodeFor([]string{"{user1}:name", other})
+	assert.ErrorAs(t, err, new(*errors.RequestError))
+	_, err = ring.nodeFor(nil)
+	assert.ErrorAs(t, err, new(*errors.RequestError))
+	_, err = (&hashRing{}).nodeFor([]string{"key"})
+	assert.ErrorAs(t, err, new(*errors.ClosingError))
+}
+
+func TestCommandKeys(t *testing.T) {
+	tests := []struct {
+		requestType protobuf.RequestType
+		args        []string
+		keys        []string
+	}{
+		{protobuf.RequestType_Get, []string{"a"}, []string{"a"}},
+		{protobuf.RequestType_Set, []string{"a", "value"}, []string{"a"}},
+		{protobuf.RequestType_MSet, []string{"a", "1", "b", "2"}, []string{"a", "b"}},
+		{protobuf.RequestType_BLPop, []string{"a", "b", "1.5"}, []string{"a", "b"}},
+		{protobuf.RequestType_LMove, []string{"a", "b", "LEFT", "RIGHT"}, []string{"a", "b"}},
+		{protobuf.RequestType_ZUnion, []string{"2", "a", "b", "WITHSCORES"}, []string{"a", "b"}},
+		{protobuf.RequestType_ZUnionStore, []string{"d", "2", "a", "b"}, []string{"d", "a", "b"}},
+		{protobuf.RequestType_BLMPop, []string{"1", "2", "a", "b", "LEFT"}, []string{"a", "b"}},
+		{protobuf.RequestType_BitOp, []string{"AND", "d", "a", "b"}, []string{"d", "a", "b"}},
+		{protobuf.RequestType_Sort, []string{"a", "STORE", "d"}, []string{"a", "d"}},
+		{protobuf.RequestType_XRead, []string{"COUNT", "1", "STREAMS", "a", "b", "0", "0"}, []string{"a", "b"}},
+		{protobuf.RequestType_XReadGroup, []string{"GROUP", "g", "STREAMS", "STREAMS", "a", "0"}, []string{"a"}},
+		{protobuf.RequestType_ZInterCard, []string{"3", "a"}, nil},
+		{protobuf.RequestType_Ping, nil, nil},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.keys, commandKeys(test.requestType, test.args), test.requestType.String())
+	}
+}
+
+func TestNewShardedClient_Config(t *testing.T) {
+	_, err := NewShardedClient(context.Background(), NewShardedClientConfiguration())
+	assert.ErrorAs(t, err, new(*errors.ConfigurationError))
+
+	config := NewShardedClientConfiguration().
+		WithNode(&NodeAddress{Host: "10.0.0.1"}, 1).
+		WithNodeConfiguration(NewGlideClientConfiguration().WithAddress(&NodeAddress{Host: "10.0.0.2"}))
+	_, err = NewShardedClient(context.Background(), config)
+	assert.ErrorAs(t, err, new(*errors.ConfigurationError))
+
+	config = NewShardedClientConfiguration().WithNode(&NodeAddress{Host: "10.0.0.1"}, 0)
+	_, err = NewShardedClient(context.Background(), config)
+	assert.ErrorAs(t, err, new(*errors.ConfigurationError))
+}
@@ -0,0 +1,228 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package api
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+const (
+	defaultSigV4TokenService = "elasticache"
+	defaultSigV4TokenExpiry  = 15 * time.Minute
+	// maxSigV4Expiry is the longest validity of a request presigned with Signature Version 4.
+	maxSigV4Expiry = 7 * 24 * time.Hour
+
+	sigV4Algorithm  = "AWS4-HMAC-SHA256"
+	sigV4TimeFormat = "20060102T150405Z"
+	sigV4DateFormat = "20060102"
+	// emptyPayloadHash is the SHA-256 hash of an empty payload, in hex.
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// SigV4TokenProvider is a [CredentialsProvider] generating authentication tokens presigned with AWS Signature Version 4,
+// such as the IAM authentication tokens of Amazon ElastiCache and MemoryDB. A token is a presigned "connect" request for
+// the user, valid for 15 minutes by default, which the client refreshes before it expires.
+//
+// For example:
+//
+//	provider := api.NewSigV4TokenProvider("my-cache", "my-user", "us-east-1", accessKeyId, secretAccessKey)
+//	config := api.NewGlideClusterClientConfiguration().
+//	    WithAddress(&api.NodeAddress{Host: "my-cache.xxxxxx.cache.amazonaws.com", Port: 6379}).
+//	    WithUseTLS(true).
+//	    WithCredentials(api.NewServerCredentialsWithProvider(provider))
+type SigV4TokenProvider struct {
+	host            string
+	userId          string
+	region          string
+	service         string
+	accessKeyId     string
+	secretAccessKey string
+	sessionToken    string
+	expiry          time.Duration
+	now             func() time.Time
+}
+
+// NewSigV4TokenProvider returns a [SigV4TokenProvider] generating tokens for userId to connect to host, which is the name
+// of the cache for Amazon ElastiCache, signed for region with the given access key. The tokens are signed for the
+// "elasticache" service, and are valid for 15 minutes. For further configuration, use the [SigV4TokenProvider] With*
+// methods.
+func NewSigV4TokenProvider(
+	host string,
+	userId string,
+	region string,
+	accessKeyId string,
+	secretAccessKey string,
+) *SigV4TokenProvider {
+	return &SigV4TokenProvider{
+		host:            host,
+		userId:          userId,
+		region:          region,
+		service:         defaultSigV4TokenService,
+		accessKeyId:     accessKeyId,
+		secretAccessKey: secretAccessKey,
+		expiry:          defaultSigV4TokenExpiry,
+		now:             time.Now,
+	}
+}
+
+// WithService sets the service the tokens are signed for, such as "memorydb".
+func (provider *SigV4TokenProvider) WithService(service string) *SigV4TokenProvider {
+	provider.service = service
+	return provider
+}
+
+// WithSessionToken sets the session token of temporary security credentials, which is included in the tokens.
+func (provider *SigV4TokenProvider) WithSessionToken(sessionToken string) *SigV4TokenProvider {
+	provider.sessionToken = sessionToken
+	return provider
+}
+
+// WithExpiry sets the time the tokens are valid for, at most 7 days.
+func (provider *SigV4TokenProvider) WithExpiry(expiry time.Duration) *SigV4TokenProvider {
+	provider.expiry = expiry
+	return provider
+}
+
+// WithClock sets the function returning the current time, used to sign the tokens.
+func (provider *SigV4TokenProvider) WithClock(now func() time.Time) *SigV4TokenProvider {
+	provider.now = now
+	return provider
+}
+
+// Credentials returns the user ID of the provider as the username, with a new token as the password.
+func (provider *SigV4TokenProvider) Credentials(ctx context.Context) (Credentials, error) {
+	now := provider.now()
+	token, err := provider.Token(now)
+	if err != nil {
+		return Credentials{}, err
+	}
+	return Credentials{Username: provider.userId, Password: token, ExpiresAt: now.Add(provider.expiry)}, nil
+}
+
+// Token returns a token signed at now.
+func (provider *SigV4TokenProvider) Token(now time.Time) (string, error) {
+	if provider.expiry < time.Second || provider.expiry > maxSigV4Expiry {
+		return "", &errors.ConfigurationError{Msg: fmt.Sprintf("invalid SigV4 token expiry %s", provider.expiry)}
+	}
+	query := map[string]string{"Action": "connect", "User": provider.userId}
+	if provider.sessionToken != "" {
+		query["X-Amz-Security-Token"] = provider.sessionToken
+	}
+	presigned := sigV4Presign(sigV4Request{
+		host:            provider.host,
+		path:            "/",
+		query:           query,
+		payloadHash:     emptyPayloadHash,
+		region:          provider.region,
+		service:         provider.service,
+		accessKeyId:     provider.accessKeyId,
+		secretAccessKey: provider.secretAccessKey,
+		expiry:          provider.expiry,
+		now:             now,
+	})
+	return provider.host + "/?" + presigned, nil
+}
+
+// sigV4Request is a GET request presigned by sigV4Presign.
+type sigV4Request struct {
+	host            string
+	path            string
+	query           map[string]string
+	payloadHash     string
+	region          string
+	service         string
+	accessKeyId     string
+	secretAccessKey string
+	expiry          time.Duration
+	now             time.Time
+}
+
+// sigV4Presign returns the query string of request presigned with AWS Signature Version 4, signing the host header only.
+func sigV4Presign(request sigV4Request) string {
+	now := request.now.UTC()
+	date := now.Format(sigV4DateFormat)
+	scope := strings.Join([]string{date, request.region, request.service, "aws4_request"}, "/")
+
+	query := make(map[string]string, len(request.query)+5)
+	for key, value := range request.query {
+		query[key] = value
+	}
+	query["X-Amz-Algorithm"] = sigV4Algorithm
+	query["X-Amz-Credential"] = request.accessKeyId + "/" + scope
+	query["X-Amz-Date"] = now.Format(sigV4TimeFormat)
+	query["X-Amz-Expires"] = strconv.FormatInt(int64(request.expiry/time.Second), 10)
+	query["X-Amz-SignedHeaders"] = "host"
+	canonicalQuery := sigV4CanonicalQuery(query)
+
+	canonicalRequest := strings.Join([]string{
+		"GET",
+		request.path,
+		canonicalQuery,
+		"host:" + request.host + "\n",
+		"host",
+		request.payloadHash,
+	}, "\n")
+	stringToSign := strings.Join([]string{
+		sigV4Algorithm,
+		query["X-Amz-Date"],
+		scope,
+		sha256Hex(canonicalRequest),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+request.secretAccessKey), date)
+	for _, part := range []string{request.region, request.service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	return canonicalQuery + "&X-Amz-Signature=" + signature
+}
+
+// sigV4CanonicalQuery returns the query string of query, sorted by key and encoded as specified by Signature Version 4.
+func sigV4CanonicalQuery(query map[string]string) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, key := range keys {
+		parts[i] = sigV4Escape(key) + "=" + sigV4Escape(query[key])
+	}
+	return strings.Join(parts, "&")
+}
+
+// sigV4Escape percent-encodes every byte of s but the unreserved characters of RFC 3986.
+func sigV4Escape(s string) string {
+	var builder strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' ||
+			c == '~' {
+			builder.WriteByte(c)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", c)
+		}
+	}
+	return builder.String()
+}
+
+func sha256Hex(s string) string {
+	hash := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -20,6 +20,7 @@ type connectionSettings struct {
 	port               int
 	useTLS             bool
 	clusterModeEnabled bool
+	connectionsPerNode int
 }
 
 func runBenchmarks(runConfig *runConfiguration) error {
@@ -57,18 +58,22 @@ func executeBenchmarks(runConfig *runConfiguration, connectionSettings *connecti
 	for _, clientName := range runConfig.clientNames {
 		for _, numConcurrentTasks := range runConfig.concurrentTasks {
 			for _, clientCount := range runConfig.clientCount {
-				for _, dataSize := range runConfig.dataSize {
-					benchmarkConfig := benchmarkConfig{
-						clientName:         clientName,
-						numConcurrentTasks: numConcurrentTasks,
-						clientCount:        clientCount,
-						dataSize:           dataSize,
-						minimal:            runConfig.minimal,
-						connectionSettings: connectionSettings,
-						resultsFile:        runConfig.resultsFile,
+				for _, connectionsPerNode := range connectionsPerNodeOptions(runConfig, clientName) {
+					for _, dataSize := range runConfig.dataSize {
+						settings := *connectionSettings
+						settings.connectionsPerNode = connectionsPerNode
+						benchmarkConfig := benchmarkConfig{
+							clientName:         clientName,
+							numConcurrentTasks: numConcurrentTasks,
+							clientCount:        clientCount,
+							dataSize:           dataSize,
+							minimal:            runConfig.minimal,
+							connectionSettings: &settings,
+							resultsFile:        runConfig.resultsFile,
+						}
+
+						benchmarkConfigs = append(benchmarkConfigs, benchmarkConfig)
 					}
-
-					benchmarkConfigs = append(benchmarkConfigs, benchmarkConfig)
 				}
 			}
 		}
@@ -84,14 +89,24 @@ func executeBenchmarks(runConfig *runConfiguration, connectionSettings *connecti
 	return nil
 }
 
+// connectionsPerNodeOptions returns the numbers of connections per node to benchmark the client with. Only the glide client
+// is configured with a number of connections per node.
+func connectionsPerNodeOptions(runConfig *runConfiguration, clientName string) []int {
+	if clientName != glide {
+		return []int{1}
+	}
+	return runConfig.connectionsPerNode
+}
+
 var key_count int64 = 1
 
 func runSingleBenchmark(config *benchmarkConfig) error {
 	fmt.Printf("Running benchmarking for %s client:\n", config.clientName)
 	fmt.Printf(
-		"\n =====> %s <===== clientCount: %d, concurrentTasks: %d, dataSize: %d \n\n",
+		"\n =====> %s <===== clientCount: %d, connectionsPerNode: %d, concurrentTasks: %d, dataSize: %d \n\n",
 		config.clientName,
 		config.clientCount,
+		config.connectionSettings.connectionsPerNode,
 		config.numConcurrentTasks,
 		config.dataSize,
 	)
@@ -455,6 +470,7 @@ func addJsonResults(config *benchmarkConfig, results *benchmarkResults) {
 	jsonResult["num_of_tasks"] = config.numConcurrentTasks
 	jsonResult["data_size"] = config.dataSize
 	jsonResult["client_count"] = config.clientCount
+	jsonResult["connections_per_node"] = config.connectionSettings.connectionsPerNode
 	jsonResult["tps"] = results.tps
 
 	for key, value := range results.latencyStats {
@@ -16,7 +16,8 @@ func (glideBenchmarkClient *glideBenchmarkClient) connect(connectionSettings *co
 	if connectionSettings.clusterModeEnabled {
 		config := api.NewGlideClusterClientConfiguration().
 			WithAddress(&api.NodeAddress{Host: connectionSettings.host, Port: connectionSettings.port}).
-			WithUseTLS(connectionSettings.useTLS)
+			WithUseTLS(connectionSettings.useTLS).
+			WithConnectionsPerNode(connectionSettings.connectionsPerNode)
 		glideClient, err := api.NewGlideClusterClient(context.Background(), config)
 		if err != nil {
 			return err
@@ -27,7 +28,8 @@ func (glideBenchmarkClient *glideBenchmarkClient) connect(connectionSettings *co
 	} else {
 		config := api.NewGlideClientConfiguration().
 			WithAddress(&api.NodeAddress{Host: connectionSettings.host, Port: connectionSettings.port}).
-			WithUseTLS(connectionSettings.useTLS)
+			WithUseTLS(connectionSettings.useTLS).
+			WithConnectionsPerNode(connectionSettings.connectionsPerNode)
 		glideClient, err := api.NewGlideClient(context.Background(), config)
 		if err != nil {
 			return err
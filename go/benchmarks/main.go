@@ -24,6 +24,7 @@ type options struct {
 	host               string
 	port               int
 	clientCount        string
+	connectionsPerNode string
 	tls                bool
 	clusterModeEnabled bool
 	minimal            bool
@@ -37,6 +38,7 @@ type runConfiguration struct {
 	host               string
 	port               int
 	clientCount        []int
+	connectionsPerNode []int
 	tls                bool
 	clusterModeEnabled bool
 	minimal            bool
@@ -82,6 +84,7 @@ func parseArguments() *options {
 	host := flag.String("host", api.DefaultHost, "Hostname")
 	port := flag.Int("port", api.DefaultPort, "Port number")
 	clientCount := flag.String("clientCount", "[1]", "Number of clients to run")
+	connectionsPerNode := flag.String("connectionsPerNode", "[1]", "Number of connections per node of the glide clients")
 	tls := flag.Bool("tls", false, "Use TLS")
 	clusterModeEnabled := flag.Bool("clusterModeEnabled", false, "Is cluster mode enabled")
 	minimal := flag.Bool("minimal", false, "Run benchmark in minimal mode")
@@ -96,6 +99,7 @@ func parseArguments() *options {
 		host:               *host,
 		port:               *port,
 		clientCount:        *clientCount,
+		connectionsPerNode: *connectionsPerNode,
 		tls:                *tls,
 		clusterModeEnabled: *clusterModeEnabled,
 		minimal:            *minimal,
@@ -145,6 +149,11 @@ func verifyOptions(opts *options) (*runConfiguration, error) {
 		return nil, fmt.Errorf("invalid clientCount option: %v", err)
 	}
 
+	runConfig.connectionsPerNode, err = parseOptionsIntList(opts.connectionsPerNode)
+	if err != nil {
+		return nil, fmt.Errorf("invalid connectionsPerNode option: %v", err)
+	}
+
 	switch {
 	case strings.EqualFold(opts.clients, goRedis):
 		runConfig.clientNames = append(runConfig.clientNames, goRedis)
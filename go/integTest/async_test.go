@@ -0,0 +1,71 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+func (suite *GlideTestSuite) TestAsync_FanOutFromSingleGoroutine() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		prefix := uuid.NewString()
+		sets := make([]*api.Future[string], 1000)
+		for i := range sets {
+			sets[i] = client.Async().Set(context.Background(), fmt.Sprintf("%s-%d", prefix, i), fmt.Sprint(i))
+		}
+		for _, future := range sets {
+			suite.verifyOK(future.Await(context.Background()))
+		}
+
+		gets := make([]*api.Future[api.Result[string]], len(sets))
+		for i := range gets {
+			gets[i] = client.Async().Get(context.Background(), fmt.Sprintf("%s-%d", prefix, i))
+		}
+		for i, future := range gets {
+			<-future.Done()
+			result, err := future.Await(context.Background())
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), fmt.Sprint(i), result.Value())
+		}
+	})
+}
+
+func (suite *GlideTestSuite) TestAsync_Errors() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := uuid.NewString()
+		_, err := client.Async().LPush(context.Background(), key, []string{"element"}).Await(context.Background())
+		assert.NoError(suite.T(), err)
+
+		// The error of the server is returned by the future.
+		_, err = client.Async().Incr(context.Background(), key).Await(context.Background())
+		assert.Error(suite.T(), err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = client.Async().Get(ctx, key).Await(context.Background())
+		assert.ErrorIs(suite.T(), err, context.Canceled)
+	})
+}
+
+func (suite *GlideTestSuite) TestAsync_DeadlineCancelsCommand() {
+	client := suite.defaultClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	// The script keeps the server busy for longer than the deadline of the command.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = client.CustomCommand(context.Background(), []string{"EVAL", slowScript, "0"})
+	}()
+	time.Sleep(10 * time.Millisecond)
+	_, err := client.Async().Get(ctx, uuid.NewString()).Await(context.Background())
+	assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+	<-done
+}
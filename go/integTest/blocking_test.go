@@ -0,0 +1,46 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+func (suite *GlideTestSuite) TestBlockingCommand_UnblockedOnCancel() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := uuid.NewString()
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		// Without unblocking, the connection would stay blocked until the list is pushed to.
+		_, err := client.BLPop(ctx, []string{key}, 0)
+		assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+
+		ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_, err = client.LPush(ctx, key, []string{"element"})
+		assert.NoError(suite.T(), err)
+		length, err := client.LLen(ctx, key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), length)
+	})
+}
+
+func (suite *GlideTestSuite) TestBlockingCommand_TimeoutLongerThanRequestTimeout() {
+	clients := []api.BaseClient{
+		suite.client(suite.defaultClientConfig().WithRequestTimeout(100 * time.Millisecond)),
+		suite.clusterClient(suite.defaultClusterClientConfig().WithRequestTimeout(100 * time.Millisecond)),
+	}
+	suite.runWithClients(clients, func(client api.BaseClient) {
+		start := time.Now()
+		result, err := client.BLPop(context.Background(), []string{uuid.NewString()}, 0.5)
+		assert.NoError(suite.T(), err)
+		assert.Nil(suite.T(), result)
+		assert.GreaterOrEqual(suite.T(), time.Since(start), 500*time.Millisecond)
+	})
+}
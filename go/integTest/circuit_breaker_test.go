@@ -0,0 +1,70 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/faults"
+)
+
+func (suite *GlideTestSuite) TestCircuitBreaker_OpensOnFailuresAndRecovers() {
+	var mu sync.Mutex
+	var transitions []api.CircuitState
+	circuitBreaker := api.NewCircuitBreakerConfig().
+		WithFailureThreshold(2).
+		WithOpenDuration(200 * time.Millisecond).
+		WithStateChangeCallback(func(node string, from api.CircuitState, to api.CircuitState) {
+			mu.Lock()
+			defer mu.Unlock()
+			transitions = append(transitions, to)
+		})
+	client := suite.clusterClient(suite.defaultClusterClientConfig().WithCircuitBreaker(circuitBreaker))
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+	injector := faults.NewInjector()
+	suite.injectFaults(client, injector)
+	id, err := injector.AddRule(faults.Rule{KeyPattern: key, Err: errors.NewTimeoutError("injected timeout")})
+	assert.NoError(suite.T(), err)
+
+	for i := 0; i < 2; i++ {
+		_, err = client.Get(context.Background(), key)
+		assert.IsType(suite.T(), &errors.TimeoutError{}, err)
+	}
+	_, err = client.Get(context.Background(), key)
+	var circuitErr *errors.CircuitOpenError
+	assert.ErrorAs(suite.T(), err, &circuitErr)
+	assert.Equal(suite.T(), 2, injector.Applied(id))
+
+	stats := client.CircuitBreakerStats()[circuitErr.Node]
+	assert.Equal(suite.T(), api.CircuitOpen, stats.State)
+	assert.Equal(suite.T(), int64(1), stats.Rejected)
+
+	// Commands which are not attributed to the node are not affected.
+	_, err = client.Ping(context.Background())
+	assert.NoError(suite.T(), err)
+
+	injector.RemoveRule(id)
+	time.Sleep(200 * time.Millisecond)
+	result, err := client.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+	assert.Equal(suite.T(), api.CircuitClosed, client.CircuitBreakerStats()[circuitErr.Node].State)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(suite.T(), []api.CircuitState{api.CircuitOpen, api.CircuitHalfOpen, api.CircuitClosed}, transitions)
+}
+
+func (suite *GlideTestSuite) TestCircuitBreaker_Disabled() {
+	client := suite.defaultClusterClient()
+	assert.Nil(suite.T(), client.CircuitBreakerStats())
+}
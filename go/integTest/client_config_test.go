@@ -0,0 +1,53 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+func (suite *GlideTestSuite) uri(address api.NodeAddress, path string) string {
+	scheme := "valkey"
+	if suite.tls {
+		scheme = "valkeys"
+	}
+	return fmt.Sprintf("%s://%s:%d%s", scheme, address.Host, address.Port, path)
+}
+
+func (suite *GlideTestSuite) TestClientConfiguration_FromURI() {
+	name := "uri-" + uuid.NewString()
+	config, err := api.NewGlideClientConfigurationFromURI(
+		suite.uri(suite.standaloneHosts[0], "/1?request_timeout=2s&client_name="+name),
+	)
+	assert.NoError(suite.T(), err)
+	client := suite.client(config)
+
+	clientName, err := client.ClientGetName(context.Background())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), name, clientName)
+	info, err := client.CustomCommand(context.Background(), []string{"CLIENT", "INFO"})
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), info, " db=1 ")
+}
+
+func (suite *GlideTestSuite) TestClusterClientConfiguration_FromConfig() {
+	addresses := make([]string, 0, len(suite.clusterHosts))
+	for _, address := range suite.clusterHosts {
+		addresses = append(addresses, fmt.Sprintf("%s:%d", address.Host, address.Port))
+	}
+	config, err := (&api.ClientConfig{Addresses: addresses, UseTLS: &suite.tls, RequestTimeout: "2s"}).
+		GlideClusterClientConfiguration()
+	assert.NoError(suite.T(), err)
+	client := suite.clusterClient(config)
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	result, err := client.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+}
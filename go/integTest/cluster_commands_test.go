@@ -5,12 +5,15 @@ package integTest
 import (
 	"context"
 	"fmt"
+	"math"
 	"math/rand"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/valkey-io/valkey-glide/go/api"
 	"github.com/valkey-io/valkey-glide/go/api/config"
 	"github.com/valkey-io/valkey-glide/go/api/errors"
@@ -2548,3 +2551,277 @@ func (suite *GlideTestSuite) TestScriptKillUnkillableWithRoute() {
 	assert.Error(suite.T(), err)
 	assert.True(suite.T(), strings.Contains(strings.ToLower(err.Error()), "notbusy"))
 }
+
+func (suite *GlideTestSuite) TestAclClusterSetGetDelUser() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	username := "user-" + uuid.NewString()
+
+	suite.verifyOK(client.AclSetUser(ctx, username, options.NewAclRules().On().AllowKeys("cache:*").AllowCommand("get")))
+
+	// The user is set on all nodes.
+	users, err := client.AclGetUser(ctx, username)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), users.IsMultiValue())
+	assert.Greater(suite.T(), len(users.MultiValue()), 1)
+	for _, user := range users.MultiValue() {
+		assert.False(suite.T(), user.IsNil())
+		assert.Equal(suite.T(), "~cache:*", user.Value().Keys)
+	}
+	names, err := client.AclUsers(ctx)
+	assert.NoError(suite.T(), err)
+	for _, nodeNames := range names.MultiValue() {
+		assert.Contains(suite.T(), nodeNames, username)
+	}
+
+	single, err := client.AclGetUserWithRoute(ctx, username, options.RouteOption{Route: config.RandomRoute})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), single.IsSingleValue())
+	assert.Equal(suite.T(), "~cache:*", single.SingleValue().Value().Keys)
+
+	deleted, err := client.AclDelUser(ctx, []string{username})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), deleted)
+	users, err = client.AclGetUser(ctx, username)
+	assert.NoError(suite.T(), err)
+	for _, user := range users.MultiValue() {
+		assert.True(suite.T(), user.IsNil())
+	}
+}
+
+func (suite *GlideTestSuite) TestAclClusterLog() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	username := "user-" + uuid.NewString()
+	suite.verifyOK(client.AclSetUser(ctx, username, options.NewAclRules().On().AddPassword("secret")))
+	defer client.AclDelUser(ctx, []string{username})
+
+	_, err := client.CustomCommandWithRoute(ctx, []string{"AUTH", username, "wrong"}, config.AllNodes)
+	assert.Error(suite.T(), err)
+
+	logs, err := client.AclLogWithOptions(ctx, options.ClusterAclLogOptions{AclLogOptions: options.NewAclLogOptions(5)})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), logs.IsMultiValue())
+	found := false
+	for _, entries := range logs.MultiValue() {
+		assert.LessOrEqual(suite.T(), len(entries), 5)
+		for _, entry := range entries {
+			found = found || (entry.Reason == "auth" && entry.Username == username)
+		}
+	}
+	assert.True(suite.T(), found)
+
+	suite.verifyOK(client.AclLogReset(ctx))
+
+	whoami, err := client.AclWhoAmI(ctx)
+	assert.NoError(suite.T(), err)
+	for _, name := range whoami.MultiValue() {
+		assert.Equal(suite.T(), "default", name)
+	}
+	_, err = client.AclLoad(ctx)
+	assert.ErrorAs(suite.T(), err, new(*errors.RequestError))
+}
+
+func (suite *GlideTestSuite) TestClusterClientAdminCommands() {
+	suite.SkipIfServerVersionLowerThanBy("7.2.0", suite.T())
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	allNodes := options.RouteOption{Route: config.AllNodes}
+
+	infos, err := client.ClientInfoWithOptions(ctx, allNodes)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), infos.IsMultiValue())
+	ids, err := client.ClientIdWithOptions(ctx, allNodes)
+	require.NoError(suite.T(), err)
+	for node, info := range infos.MultiValue() {
+		assert.Equal(suite.T(), ids.MultiValue()[node], info.Id)
+	}
+	info, err := client.ClientInfo(ctx)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), info.IsSingleValue())
+
+	lists, err := client.ClientListWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+	assert.NoError(suite.T(), err)
+	for _, clients := range lists.MultiValue() {
+		assert.NotEmpty(suite.T(), clients)
+	}
+
+	suite.verifyOK(client.ClientSetInfo(ctx, options.ClientLibVersion, "1.2.3"))
+	suite.verifyOK(client.ClientNoEvictWithOptions(ctx, true, allNodes))
+	infos, err = client.ClientInfoWithOptions(ctx, allNodes)
+	require.NoError(suite.T(), err)
+	for _, info := range infos.MultiValue() {
+		assert.Equal(suite.T(), "1.2.3", info.LibVersion)
+		assert.Contains(suite.T(), info.Flags, "e")
+	}
+	suite.verifyOK(client.ClientNoEvictWithOptions(ctx, false, allNodes))
+	suite.verifyOK(client.ClientNoTouchWithOptions(ctx, false, allNodes))
+
+	redirs, err := client.ClientGetRedirWithOptions(ctx, allNodes)
+	assert.NoError(suite.T(), err)
+	for _, redir := range redirs.MultiValue() {
+		assert.Equal(suite.T(), int64(-1), redir)
+	}
+
+	suite.verifyOK(client.ClientPauseWithOptions(ctx, 100, options.PauseWrite, options.RouteOption{Route: config.AllPrimaries}))
+	suite.verifyOK(client.ClientUnpauseWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries}))
+
+	// There is no connection with such an id.
+	killed, err := client.ClientKillWithOptions(ctx, options.NewClientKillOptions().SetId(math.MaxInt64), allNodes)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(0), killed)
+	unblocked, err := client.ClientUnblockWithOptions(ctx, math.MaxInt64, false, allNodes)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), unblocked)
+}
+
+func (suite *GlideTestSuite) TestClusterSlowLogCommands() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+	suite.verifyOK(client.ConfigSet(ctx, map[string]string{"slowlog-log-slower-than": "0"}))
+	defer client.ConfigSet(ctx, map[string]string{"slowlog-log-slower-than": "10000"})
+
+	suite.verifyOK(client.SlowLogReset(ctx))
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+
+	// The entries of every node are returned separately, rather than combined.
+	lengths, err := client.SlowLogLen(ctx)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), lengths.IsMultiValue())
+	entries, err := client.SlowLogGetWithOptions(
+		ctx,
+		options.ClusterSlowLogOptions{SlowLogOptions: options.NewSlowLogOptions(-1)},
+	)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), len(lengths.MultiValue()), len(entries.MultiValue()))
+	found := false
+	for _, nodeEntries := range entries.MultiValue() {
+		for _, entry := range nodeEntries {
+			found = found || slices.Equal(entry.Args, []string{"SET", key, "value"})
+		}
+	}
+	assert.True(suite.T(), found)
+
+	primaries, err := client.SlowLogLenWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+	assert.NoError(suite.T(), err)
+	assert.LessOrEqual(suite.T(), len(primaries.MultiValue()), len(lengths.MultiValue()))
+	keyRoute := options.RouteOption{Route: config.NewSlotKeyRoute(config.SlotTypePrimary, key)}
+	length, err := client.SlowLogLenWithOptions(ctx, keyRoute)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), length.IsSingleValue())
+	suite.verifyOK(client.SlowLogResetWithOptions(ctx, keyRoute))
+}
+
+func (suite *GlideTestSuite) TestClusterLatencyAndMemoryCommands() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+
+	events, err := client.LatencyLatest(ctx)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), events.IsMultiValue())
+	reports, err := client.LatencyDoctorWithOptions(ctx, options.RouteOption{Route: config.RandomRoute})
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), reports.SingleValue())
+	_, err = client.LatencyReset(ctx, []string{})
+	assert.NoError(suite.T(), err)
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	usage, err := client.MemoryUsage(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Greater(suite.T(), usage.Value(), int64(0))
+
+	stats, err := client.MemoryStats(ctx)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), stats.IsMultiValue())
+	for _, nodeStats := range stats.MultiValue() {
+		assert.Greater(suite.T(), nodeStats.TotalAllocated, int64(0))
+	}
+	doctors, err := client.MemoryDoctorWithOptions(ctx, options.RouteOption{Route: config.AllNodes})
+	assert.NoError(suite.T(), err)
+	assert.GreaterOrEqual(suite.T(), len(doctors.MultiValue()), len(stats.MultiValue()))
+	_, err = client.MemoryMallocStats(ctx)
+	assert.NoError(suite.T(), err)
+	suite.verifyOK(client.MemoryPurge(ctx))
+}
+
+func (suite *GlideTestSuite) TestClusterIntrospectionCommands() {
+	client := suite.defaultClusterClient()
+	ctx := context.Background()
+
+	state, err := client.ClusterInfo(ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "ok", state.SingleValue().State)
+	assert.Equal(suite.T(), int64(16384), state.SingleValue().SlotsAssigned)
+
+	ids, err := client.ClusterMyId(ctx)
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), ids.IsMultiValue())
+	nodes, err := client.ClusterNodes(ctx)
+	require.NoError(suite.T(), err)
+	assert.Len(suite.T(), nodes.SingleValue(), len(ids.MultiValue()))
+	for _, id := range ids.MultiValue() {
+		assert.True(suite.T(), slices.ContainsFunc(nodes.SingleValue(), func(node api.ClusterNode) bool { return node.Id == id }))
+	}
+
+	slotEntries, err := client.ClusterSlots(ctx)
+	require.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), slotEntries.SingleValue())
+
+	key := "{" + uuid.NewString() + "}"
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	slot, err := client.ClusterKeySlot(ctx, key)
+	require.NoError(suite.T(), err)
+	count, err := client.ClusterCountKeysInSlot(ctx, slot)
+	assert.NoError(suite.T(), err)
+	assert.GreaterOrEqual(suite.T(), count, int64(1))
+	keys, err := client.ClusterGetKeysInSlot(ctx, slot, 10)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), keys, key)
+	_, err = client.ClusterCountKeysInSlot(ctx, 16384)
+	assert.Error(suite.T(), err)
+
+	if suite.serverVersion >= "7.0.0" {
+		topology, err := client.Topology(ctx)
+		require.NoError(suite.T(), err)
+		assert.True(suite.T(), topology.IsHealthy())
+		assert.NotEmpty(suite.T(), topology.Primaries())
+		shard, ok := topology.ShardOfSlot(slot)
+		require.True(suite.T(), ok)
+		primary, _ := shard.Primary()
+		assert.Equal(suite.T(), "online", primary.Health)
+
+		links, err := client.ClusterLinksWithOptions(ctx, options.RouteOption{Route: config.AllPrimaries})
+		assert.NoError(suite.T(), err)
+		for _, nodeLinks := range links.MultiValue() {
+			assert.NotEmpty(suite.T(), nodeLinks)
+		}
+	}
+
+	if suite.serverVersion >= "7.2.0" {
+		shardIds, err := client.ClusterMyShardIdWithOptions(ctx, options.RouteOption{Route: config.RandomRoute})
+		assert.NoError(suite.T(), err)
+		assert.NotEmpty(suite.T(), shardIds.SingleValue())
+	}
+
+	if suite.serverVersion >= "8.0.0" {
+		stats, err := client.ClusterSlotStats(ctx, *options.NewSlotStatsRangeOptions(slot, slot))
+		require.NoError(suite.T(), err)
+		found := false
+		for _, nodeStats := range stats.MultiValue() {
+			for _, slotStats := range nodeStats {
+				if slotStats.Slot == slot {
+					found = true
+					assert.GreaterOrEqual(suite.T(), slotStats.KeyCount, int64(1))
+				}
+			}
+		}
+		assert.True(suite.T(), found)
+		_, err = client.ClusterSlotStats(
+			ctx,
+			*options.NewSlotStatsOrderByOptions(options.SlotStatsKeyCount).SetLimit(3).SetOrder(options.DESC),
+		)
+		assert.NoError(suite.T(), err)
+	}
+}
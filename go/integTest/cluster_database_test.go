@@ -0,0 +1,125 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/integTest/clustermanager"
+)
+
+// startMultiDatabaseCluster starts a cluster with 16 databases, and returns the address of one of its nodes. Multiple
+// databases are supported in cluster mode from Valkey 9.0.
+func (suite *GlideTestSuite) startMultiDatabaseCluster() api.NodeAddress {
+	suite.SkipIfServerVersionLowerThanBy("9.0.0", suite.T())
+	cluster := clustermanager.StartForTest(suite.T(), clustermanager.Config{
+		ClusterMode: true,
+		Replicas:    1,
+		TLS:         suite.tls,
+		ServerArgs:  []string{"--cluster-databases", "16"},
+	})
+	return cluster.Addresses()[0]
+}
+
+func (suite *GlideTestSuite) databaseClusterConfig(address api.NodeAddress) *api.GlideClusterClientConfiguration {
+	return api.NewGlideClusterClientConfiguration().
+		WithAddress(&address).
+		WithUseTLS(suite.tls).
+		WithRequestTimeout(5 * time.Second)
+}
+
+// databaseKeys returns keys spread between the slots of all the shards.
+func databaseKeys() []string {
+	keys := make([]string, 20)
+	for i := range keys {
+		keys[i] = uuid.NewString()
+	}
+	return keys
+}
+
+func (suite *GlideTestSuite) TestClusterDatabase_WithDatabaseId() {
+	address := suite.startMultiDatabaseCluster()
+	ctx := context.Background()
+	defaultDB := suite.clusterClient(suite.databaseClusterConfig(address))
+	selected := suite.clusterClient(suite.databaseClusterConfig(address).WithDatabaseId(1))
+
+	for _, key := range databaseKeys() {
+		suite.verifyOK(selected.Set(ctx, key, "value"))
+		value, err := defaultDB.Get(ctx, key)
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), value.IsNil())
+		value, err = selected.Get(ctx, key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", value.Value())
+	}
+}
+
+func (suite *GlideTestSuite) TestClusterDatabase_SelectMoveSwapDB() {
+	address := suite.startMultiDatabaseCluster()
+	ctx := context.Background()
+	client := suite.clusterClient(suite.databaseClusterConfig(address).WithConnectionsPerNode(2))
+	db2 := suite.clusterClient(suite.databaseClusterConfig(address).WithDatabaseId(2))
+	db3 := suite.clusterClient(suite.databaseClusterConfig(address).WithDatabaseId(3))
+
+	// Every node and every connection use the selected database.
+	suite.verifyOK(client.Select(ctx, 2))
+	keys := databaseKeys()
+	for _, key := range keys {
+		suite.verifyOK(client.Set(ctx, key, "value"))
+		value, err := db2.Get(ctx, key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", value.Value())
+	}
+
+	moved, err := client.Move(ctx, keys[0], 3)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), moved)
+	value, err := db3.Get(ctx, keys[0])
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+
+	suite.verifyOK(client.SwapDB(ctx, 2, 3))
+	for _, key := range keys[1:] {
+		count, err := db3.Exists(ctx, []string{key})
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), count)
+	}
+	count, err := db2.Exists(ctx, []string{keys[0]})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), count)
+
+	_, err = client.Select(ctx, 100)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestClusterDatabase_SelectSurvivesReconnect() {
+	address := suite.startMultiDatabaseCluster()
+	ctx := context.Background()
+	client := suite.clusterClient(suite.databaseClusterConfig(address))
+	admin := suite.clusterClient(suite.databaseClusterConfig(address))
+
+	suite.verifyOK(client.Select(ctx, 4))
+	keys := databaseKeys()
+	for _, key := range keys {
+		suite.verifyOK(client.Set(ctx, key, "value"))
+	}
+
+	// Closing the connections of the client on every node makes it reconnect.
+	_, err := admin.CustomCommandWithRoute(
+		ctx,
+		[]string{"CLIENT", "KILL", "TYPE", "normal", "SKIPME", "yes"},
+		config.AllNodes,
+	)
+	require.NoError(suite.T(), err)
+
+	assert.Eventually(suite.T(), func() bool {
+		count, err := client.Exists(ctx, keys)
+		return err == nil && count == int64(len(keys))
+	}, 10*time.Second, 100*time.Millisecond)
+}
@@ -0,0 +1,428 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Package clustermanager starts and stops local valkey-server processes for tests. It can start a standalone primary with
+// replicas or a cluster with any number of shards and replicas, optionally with TLS and loaded modules.
+//
+// A deployment is usually started from TestMain or from a test with [StartForTest], which registers the teardown with
+// t.Cleanup:
+//
+//	cluster := clustermanager.StartForTest(t, clustermanager.Config{ClusterMode: true, Replicas: 1})
+//	config := api.NewGlideClusterClientConfiguration().WithAddress(&cluster.Addresses()[0])
+package clustermanager
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+const (
+	// DefaultShards is the number of primaries started in cluster mode when Config.Shards is not set.
+	DefaultShards = 3
+	// DefaultStartupTimeout is used when Config.StartupTimeout is not set.
+	DefaultStartupTimeout = 30 * time.Second
+	// DefaultMasterName is the name under which the sentinels monitor the primary when Config.MasterName is not set.
+	DefaultMasterName = "mymaster"
+
+	totalSlots = 16384
+)
+
+// Config describes the deployment to start.
+type Config struct {
+	// ClusterMode starts the servers with cluster support enabled and joins them into a single cluster. Otherwise, a single
+	// standalone primary is started and the replicas replicate from it.
+	ClusterMode bool
+	// Shards is the number of primaries in cluster mode. Ignored in standalone mode. Defaults to [DefaultShards].
+	Shards int
+	// Replicas is the number of replicas per primary.
+	Replicas int
+	// TLS enables TLS for clients, replication and the cluster bus, using certificates generated for the deployment.
+	TLS bool
+	// Modules lists paths of modules to load into every server.
+	Modules []string
+	// Host is the address the servers bind to. Defaults to 127.0.0.1.
+	Host string
+	// ServerBinary is the server executable. Defaults to valkey-server, or redis-server if valkey-server is not in PATH.
+	ServerBinary string
+	// ServerArgs are extra arguments appended to the command line of every server.
+	ServerArgs []string
+	// Dir is the folder in which the node folders are created. Defaults to a new temporary folder.
+	Dir string
+	// KeepDir keeps the node folders, including the server logs, after Stop.
+	KeepDir bool
+	// StartupTimeout bounds each wait performed while starting the deployment. Defaults to [DefaultStartupTimeout].
+	StartupTimeout time.Duration
+	// Sentinels is the number of sentinels started to monitor the primary. Only supported in standalone mode without TLS.
+	Sentinels int
+	// MasterName is the name under which the sentinels monitor the primary. Defaults to [DefaultMasterName].
+	MasterName string
+}
+
+// Cluster is a running deployment of servers, either standalone or in cluster mode.
+type Cluster struct {
+	config        Config
+	dir           string
+	serverBinary  string
+	serverVersion int
+	tlsFiles      *TLSFiles
+	clientTLS     *tls.Config
+	nodes         []*Node
+	sentinels     []*Node
+	stopOnce      sync.Once
+	stopErr       error
+}
+
+// Start starts the servers described by config and waits until the deployment is ready: in standalone mode all replicas are
+// in sync with the primary, and in cluster mode all slots are covered and every node has the same view of the topology.
+// If Start fails, all processes started so far are stopped.
+func Start(ctx context.Context, config Config) (*Cluster, error) {
+	if config.Host == "" {
+		config.Host = "127.0.0.1"
+	}
+	if config.Shards == 0 {
+		config.Shards = DefaultShards
+	}
+	if !config.ClusterMode {
+		config.Shards = 1
+	}
+	if config.StartupTimeout == 0 {
+		config.StartupTimeout = DefaultStartupTimeout
+	}
+	if config.MasterName == "" {
+		config.MasterName = DefaultMasterName
+	}
+	if config.Shards < 0 || config.Replicas < 0 || config.Sentinels < 0 {
+		return nil, fmt.Errorf(
+			"invalid configuration: %d shards, %d replicas, %d sentinels", config.Shards, config.Replicas, config.Sentinels,
+		)
+	}
+	if config.Sentinels > 0 && (config.ClusterMode || config.TLS) {
+		return nil, errors.New("sentinels are only supported in standalone mode without TLS")
+	}
+
+	cluster := &Cluster{config: config}
+	var err error
+	if cluster.serverBinary, err = findServerBinary(config.ServerBinary); err != nil {
+		return nil, err
+	}
+	if cluster.serverVersion, err = serverMajorVersion(cluster.serverBinary); err != nil {
+		return nil, err
+	}
+
+	prefix := "standalone-"
+	if config.ClusterMode {
+		prefix = "cluster-"
+	}
+	if config.Dir != "" {
+		if err = os.MkdirAll(config.Dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	if cluster.dir, err = os.MkdirTemp(config.Dir, prefix); err != nil {
+		return nil, err
+	}
+
+	if err = cluster.start(ctx); err != nil {
+		_ = cluster.Stop()
+		return nil, err
+	}
+	return cluster, nil
+}
+
+// StartForTest starts the deployment described by config and registers its teardown with t.Cleanup. The test fails
+// immediately if the deployment cannot be started.
+func StartForTest(t testing.TB, config Config) *Cluster {
+	t.Helper()
+	cluster, err := Start(context.Background(), config)
+	if err != nil {
+		t.Fatalf("failed to start servers: %s", err.Error())
+	}
+	t.Cleanup(func() {
+		if err := cluster.Stop(); err != nil {
+			t.Logf("failed to stop servers: %s", err.Error())
+		}
+	})
+	return cluster
+}
+
+func (cluster *Cluster) start(ctx context.Context) error {
+	if cluster.config.TLS {
+		files, err := generateTLSFiles(cluster.dir, cluster.config.Host)
+		if err != nil {
+			return fmt.Errorf("failed to generate TLS certificates: %w", err)
+		}
+		cluster.tlsFiles = files
+		if cluster.clientTLS, err = files.clientTLSConfig(); err != nil {
+			return err
+		}
+	}
+
+	// Primaries come first, so that the first address is always a primary.
+	count := cluster.config.Shards * (1 + cluster.config.Replicas)
+	nodes := make([]*Node, count)
+	errs := make([]error, count)
+	var wg sync.WaitGroup
+	for i := range nodes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			nodes[i], errs[i] = cluster.startNode(ctx, i < cluster.config.Shards)
+		}(i)
+	}
+	wg.Wait()
+	for _, node := range nodes {
+		if node != nil {
+			cluster.nodes = append(cluster.nodes, node)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	if cluster.config.ClusterMode {
+		return cluster.createCluster(ctx)
+	}
+	if err := cluster.createReplication(ctx); err != nil {
+		return err
+	}
+	return cluster.startSentinels(ctx)
+}
+
+// Addresses returns the addresses of all nodes. The first address is always a primary.
+func (cluster *Cluster) Addresses() []api.NodeAddress {
+	addresses := make([]api.NodeAddress, 0, len(cluster.nodes))
+	for _, node := range cluster.nodes {
+		addresses = append(addresses, node.Address)
+	}
+	return addresses
+}
+
+// Nodes returns all nodes, primaries first.
+func (cluster *Cluster) Nodes() []*Node {
+	return cluster.nodes
+}
+
+// Primaries returns the nodes that were started as primaries.
+func (cluster *Cluster) Primaries() []*Node {
+	return cluster.nodes[:cluster.config.Shards]
+}
+
+// Replicas returns the nodes that were started as replicas.
+func (cluster *Cluster) Replicas() []*Node {
+	return cluster.nodes[cluster.config.Shards:]
+}
+
+// TLSFiles returns the generated certificates, or nil if TLS is disabled.
+func (cluster *Cluster) TLSFiles() *TLSFiles {
+	return cluster.tlsFiles
+}
+
+// Dir returns the folder containing the node folders.
+func (cluster *Cluster) Dir() string {
+	return cluster.dir
+}
+
+// Stop shuts down all servers and removes their folders, unless Config.KeepDir is set. It is safe to call Stop more than
+// once.
+func (cluster *Cluster) Stop() error {
+	cluster.stopOnce.Do(func() {
+		var wg sync.WaitGroup
+		for _, node := range append(cluster.sentinels, cluster.nodes...) {
+			wg.Add(1)
+			go func(node *Node) {
+				defer wg.Done()
+				cluster.stopNode(node)
+			}(node)
+		}
+		wg.Wait()
+		if !cluster.config.KeepDir && cluster.dir != "" {
+			cluster.stopErr = os.RemoveAll(cluster.dir)
+		}
+	})
+	return cluster.stopErr
+}
+
+// createReplication makes every replica replicate from the first node and waits until they are in sync.
+func (cluster *Cluster) createReplication(ctx context.Context) error {
+	primary := cluster.nodes[0]
+	for _, replica := range cluster.Replicas() {
+		_, err := cluster.command(replica, "REPLICAOF", primary.Address.Host, strconv.Itoa(primary.Address.Port))
+		if err != nil {
+			return fmt.Errorf("failed to set up replication for %s: %w", replica.addressString(), err)
+		}
+	}
+	for _, replica := range cluster.Replicas() {
+		if err := cluster.waitForReplicaLink(ctx, replica); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cluster *Cluster) waitForReplicaLink(ctx context.Context, replica *Node) error {
+	return cluster.poll(ctx, fmt.Sprintf("replica %s to sync", replica.addressString()), func() (bool, error) {
+		info, err := cluster.commandString(replica, "INFO", "replication")
+		if err != nil {
+			return false, nil
+		}
+		return parseInfo(info)["master_link_status"] == "up", nil
+	})
+}
+
+// createCluster assigns the slots evenly between the primaries, joins all nodes into one cluster, attaches the replicas to
+// the primaries and waits until the cluster is healthy.
+func (cluster *Cluster) createCluster(ctx context.Context) error {
+	primaries := cluster.Primaries()
+	for i, primary := range primaries {
+		start := i * totalSlots / len(primaries)
+		end := (i+1)*totalSlots/len(primaries) - 1
+		args := make([]string, 0, end-start+3)
+		args = append(args, "CLUSTER", "ADDSLOTS")
+		for slot := start; slot <= end; slot++ {
+			args = append(args, strconv.Itoa(slot))
+		}
+		if _, err := cluster.command(primary, args...); err != nil {
+			return fmt.Errorf("failed to assign slots to %s: %w", primary.addressString(), err)
+		}
+	}
+
+	meetHost, err := resolveIP(cluster.config.Host)
+	if err != nil {
+		return err
+	}
+	first := cluster.nodes[0]
+	for _, node := range cluster.nodes[1:] {
+		if _, err := cluster.command(first, "CLUSTER", "MEET", meetHost, strconv.Itoa(node.Address.Port)); err != nil {
+			return fmt.Errorf("failed to add %s to the cluster: %w", node.addressString(), err)
+		}
+	}
+
+	for _, node := range cluster.nodes {
+		if err := cluster.waitForClusterNodes(ctx, node); err != nil {
+			return err
+		}
+	}
+
+	for i, replica := range cluster.Replicas() {
+		primary := primaries[i%len(primaries)]
+		if _, err := cluster.command(replica, "CLUSTER", "REPLICATE", primary.ID); err != nil {
+			return fmt.Errorf("failed to attach replica %s to %s: %w", replica.addressString(), primary.addressString(), err)
+		}
+	}
+
+	for _, node := range cluster.nodes {
+		if err := cluster.waitForTopology(ctx, node); err != nil {
+			return err
+		}
+	}
+	for _, replica := range cluster.Replicas() {
+		if err := cluster.waitForReplicaLink(ctx, replica); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForClusterNodes waits until the node has completed the handshake with every other node and records its ID.
+func (cluster *Cluster) waitForClusterNodes(ctx context.Context, node *Node) error {
+	return cluster.poll(ctx, fmt.Sprintf("node %s to see all nodes", node.addressString()), func() (bool, error) {
+		output, err := cluster.commandString(node, "CLUSTER", "NODES")
+		if err != nil {
+			return false, nil
+		}
+		entries := parseClusterNodes(output)
+		for _, entry := range entries {
+			if entry.myself {
+				node.ID = entry.id
+			}
+			if entry.handshake {
+				return false, nil
+			}
+		}
+		return len(entries) == len(cluster.nodes), nil
+	})
+}
+
+// waitForTopology waits until the node reports a healthy cluster and sees every replica attached to a primary.
+func (cluster *Cluster) waitForTopology(ctx context.Context, node *Node) error {
+	return cluster.poll(ctx, fmt.Sprintf("node %s to see the full topology", node.addressString()), func() (bool, error) {
+		info, err := cluster.commandString(node, "CLUSTER", "INFO")
+		if err != nil || parseInfo(info)["cluster_state"] != "ok" {
+			return false, nil
+		}
+		output, err := cluster.commandString(node, "CLUSTER", "NODES")
+		if err != nil {
+			return false, nil
+		}
+		replicas := 0
+		for _, entry := range parseClusterNodes(output) {
+			if entry.failed {
+				return false, nil
+			}
+			if !entry.primary {
+				replicas++
+			}
+		}
+		return replicas == len(cluster.Replicas()), nil
+	})
+}
+
+type clusterNodesEntry struct {
+	id        string
+	address   string
+	myself    bool
+	primary   bool
+	handshake bool
+	failed    bool
+}
+
+// parseClusterNodes parses the output of CLUSTER NODES.
+func parseClusterNodes(output string) []clusterNodesEntry {
+	var entries []clusterNodesEntry
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 8 {
+			continue
+		}
+		entry := clusterNodesEntry{id: fields[0], address: fields[1]}
+		for _, flag := range strings.Split(fields[2], ",") {
+			switch flag {
+			case "myself":
+				entry.myself = true
+			case "master":
+				entry.primary = true
+			case "handshake", "noaddr":
+				entry.handshake = true
+			case "fail", "fail?":
+				entry.failed = true
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// resolveIP returns an IP address for host, since CLUSTER MEET does not accept host names on older servers.
+func resolveIP(host string) (string, error) {
+	if net.ParseIP(host) != nil {
+		return host, nil
+	}
+	addresses, err := net.LookupHost(host)
+	if err != nil {
+		return "", err
+	}
+	if len(addresses) == 0 {
+		return "", fmt.Errorf("no addresses found for %s", host)
+	}
+	return addresses[0], nil
+}
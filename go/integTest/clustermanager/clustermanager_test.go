@@ -0,0 +1,142 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package clustermanager
+
+import (
+	"bufio"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+func TestEncodeCommand(t *testing.T) {
+	assert.Equal(t, "*2\r\n$4\r\nECHO\r\n$0\r\n\r\n", string(encodeCommand([]string{"ECHO", ""})))
+}
+
+func TestReadReply(t *testing.T) {
+	input := "*5\r\n+OK\r\n:42\r\n$5\r\nhello\r\n$-1\r\n-ERR oops\r\n"
+	reply, err := readReply(bufio.NewReader(strings.NewReader(input)))
+	require.NoError(t, err)
+	items := reply.([]any)
+	assert.Equal(t, "OK", items[0])
+	assert.Equal(t, int64(42), items[1])
+	assert.Equal(t, "hello", items[2])
+	assert.Nil(t, items[3])
+	assert.Equal(t, &respError{msg: "ERR oops"}, items[4])
+}
+
+func TestParseInfo(t *testing.T) {
+	info := parseInfo("# Replication\r\nrole:slave\r\nmaster_link_status:up\r\n")
+	assert.Equal(t, map[string]string{"role": "slave", "master_link_status": "up"}, info)
+}
+
+func TestParseClusterNodes(t *testing.T) {
+	output := "07c37dfeb235213a872192d90877d0cd55635b91 127.0.0.1:30004@31004 slave " +
+		"e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 0 1426238317239 4 connected\n" +
+		"e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca 127.0.0.1:30001@31001 myself,master - 0 0 1 connected 0-5460\n" +
+		"292f8b365bb7edb5e285caf0b7e6ddc7265d2f4f 127.0.0.1:30003@31003 handshake - 1426238318243 0 0 connected\n"
+	entries := parseClusterNodes(output)
+	require.Len(t, entries, 3)
+	assert.False(t, entries[0].primary)
+	assert.True(t, entries[1].myself)
+	assert.True(t, entries[1].primary)
+	assert.Equal(t, "e7d1eecce10fd6bb5eb35b9f99a514335d9ba9ca", entries[1].id)
+	assert.True(t, entries[2].handshake)
+}
+
+func TestGenerateTLSFiles(t *testing.T) {
+	files, err := generateTLSFiles(t.TempDir(), "127.0.0.1")
+	require.NoError(t, err)
+
+	caPem, err := os.ReadFile(files.CACert)
+	require.NoError(t, err)
+	serverPem, err := os.ReadFile(files.ServerCert)
+	require.NoError(t, err)
+
+	pool := x509.NewCertPool()
+	require.True(t, pool.AppendCertsFromPEM(caPem))
+	block, _ := pem.Decode(serverPem)
+	require.NotNil(t, block)
+	serverCert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	_, err = serverCert.Verify(x509.VerifyOptions{Roots: pool, DNSName: "127.0.0.1"})
+	assert.NoError(t, err)
+}
+
+func TestStartStandaloneWithReplicas(t *testing.T) {
+	if _, err := findServerBinary(""); err != nil {
+		t.Skip(err.Error())
+	}
+	cluster := StartForTest(t, Config{Replicas: 1})
+	require.Len(t, cluster.Addresses(), 2)
+
+	info, err := cluster.commandString(cluster.Replicas()[0], "INFO", "replication")
+	require.NoError(t, err)
+	assert.Equal(t, "slave", parseInfo(info)["role"])
+}
+
+func TestStartClusterWithTLS(t *testing.T) {
+	if _, err := findServerBinary(""); err != nil {
+		t.Skip(err.Error())
+	}
+	cluster := StartForTest(t, Config{ClusterMode: true, Replicas: 1, TLS: true})
+	require.Len(t, cluster.Addresses(), 6)
+	require.NotNil(t, cluster.TLSFiles())
+
+	info, err := cluster.commandString(cluster.Nodes()[0], "CLUSTER", "INFO")
+	require.NoError(t, err)
+	assert.Equal(t, "ok", parseInfo(info)["cluster_state"])
+	for _, node := range cluster.Nodes() {
+		assert.NotEmpty(t, node.ID)
+	}
+}
+
+func TestSentinelArgs(t *testing.T) {
+	cluster := &Cluster{
+		config: Config{Host: "127.0.0.1", Sentinels: 3, MasterName: DefaultMasterName},
+		nodes:  []*Node{{Address: api.NodeAddress{Host: "127.0.0.1", Port: 6379}, Primary: true}},
+	}
+	dir := t.TempDir()
+	args, err := cluster.sentinelArgs(26379, dir)
+	require.NoError(t, err)
+	require.Len(t, args, 2)
+	assert.Equal(t, "--sentinel", args[1])
+	content, err := os.ReadFile(args[0])
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "port 26379\n")
+	assert.Contains(t, string(content), "sentinel monitor mymaster 127.0.0.1 6379 2\n")
+}
+
+func TestFreePortInClusterMode(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		port, err := freePort("127.0.0.1", true)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, port, maxClusterPort)
+		_, err = listenFreePort("127.0.0.1", strconv.Itoa(port+clusterBusPortOffset))
+		assert.NoError(t, err)
+	}
+}
+
+func TestServerArgsSetClusterPort(t *testing.T) {
+	cluster := &Cluster{config: Config{Host: "127.0.0.1", ClusterMode: true}, serverVersion: 8}
+	args := strings.Join(cluster.serverArgs(30001, t.TempDir()), " ")
+	assert.Contains(t, args, "--port 30001")
+	assert.Contains(t, args, "--cluster-port 40001")
+
+	cluster.config.ClusterMode = false
+	assert.NotContains(t, strings.Join(cluster.serverArgs(30001, t.TempDir()), " "), "--cluster-port")
+}
+
+func TestIsPortConflict(t *testing.T) {
+	assert.True(t, isPortConflict("# Warning: Could not create server TCP listening socket *:6379: bind: Address already in use"))
+	assert.True(t, isPortConflict("# Port number too high. Cluster communication port is 10,000 port numbers higher than "+
+		"your port. Your port number must be 55535 or less."))
+	assert.False(t, isPortConflict("# Fatal error loading the DB: Invalid argument. Exiting."))
+}
@@ -0,0 +1,76 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+// Command withservers starts a standalone server and a cluster with the cluster manager, runs a command while they are up,
+// and stops them. The command finds the servers in the GLIDE_NODE and GLIDE_CLUSTER_NODES environment variables, as
+// comma separated "host:port" addresses:
+//
+//	go run ./integTest/clustermanager/cmd/withservers -- sh -c 'go test ./api -standalonenode $GLIDE_NODE'
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/valkey-io/valkey-glide/go/integTest/clustermanager"
+)
+
+func main() {
+	os.Exit(run())
+}
+
+func run() int {
+	tls := flag.Bool("tls", false, "enable TLS on the servers")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: withservers [-tls] -- command [args...]")
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	standalone, err := clustermanager.Start(ctx, clustermanager.Config{TLS: *tls})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to start the standalone server:", err)
+		return 1
+	}
+	defer standalone.Stop()
+	cluster, err := clustermanager.Start(ctx, clustermanager.Config{ClusterMode: true, Replicas: 1, TLS: *tls})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to start the cluster:", err)
+		return 1
+	}
+	defer cluster.Stop()
+
+	cmd := exec.CommandContext(ctx, flag.Arg(0), flag.Args()[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	cmd.Env = append(os.Environ(),
+		"GLIDE_NODE="+addresses(standalone.Primaries()),
+		"GLIDE_CLUSTER_NODES="+addresses(cluster.Nodes()),
+	)
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode()
+		}
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+func addresses(nodes []*clustermanager.Node) string {
+	result := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, net.JoinHostPort(node.Address.Host, strconv.Itoa(node.Address.Port)))
+	}
+	return strings.Join(result, ",")
+}
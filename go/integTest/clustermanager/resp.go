@@ -0,0 +1,169 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package clustermanager
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// respError is an error reply returned by the server.
+type respError struct {
+	msg string
+}
+
+func (e *respError) Error() string { return e.msg }
+
+// respConn is a minimal RESP2 connection used to bootstrap and inspect the servers started by this package. It is
+// deliberately independent of the GLIDE client, so that the test infrastructure does not depend on the code under test.
+type respConn struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	timeout time.Duration
+}
+
+func dialResp(address string, tlsConfig *tls.Config, timeout time.Duration) (*respConn, error) {
+	dialer := &net.Dialer{Timeout: timeout}
+	var conn net.Conn
+	var err error
+	if tlsConfig != nil {
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", address)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &respConn{conn: conn, reader: bufio.NewReader(conn), timeout: timeout}, nil
+}
+
+func (c *respConn) Close() error {
+	return c.conn.Close()
+}
+
+// do sends a command and reads its reply. The reply is one of string, int64, []any, nil or *respError.
+func (c *respConn) do(args ...string) (any, error) {
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return nil, err
+	}
+	if _, err := c.conn.Write(encodeCommand(args)); err != nil {
+		return nil, err
+	}
+	reply, err := readReply(c.reader)
+	if err != nil {
+		return nil, err
+	}
+	if replyErr, ok := reply.(*respError); ok {
+		return nil, replyErr
+	}
+	return reply, nil
+}
+
+// doString sends a command and expects a simple or bulk string reply.
+func (c *respConn) doString(args ...string) (string, error) {
+	reply, err := c.do(args...)
+	if err != nil {
+		return "", err
+	}
+	str, ok := reply.(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected reply to %s: %v", args[0], reply)
+	}
+	return str, nil
+}
+
+func encodeCommand(args []string) []byte {
+	var builder strings.Builder
+	builder.WriteString("*")
+	builder.WriteString(strconv.Itoa(len(args)))
+	builder.WriteString("\r\n")
+	for _, arg := range args {
+		builder.WriteString("$")
+		builder.WriteString(strconv.Itoa(len(arg)))
+		builder.WriteString("\r\n")
+		builder.WriteString(arg)
+		builder.WriteString("\r\n")
+	}
+	return []byte(builder.String())
+}
+
+func readLine(reader *bufio.Reader) (string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasSuffix(line, "\r\n") {
+		return "", fmt.Errorf("malformed RESP line: %q", line)
+	}
+	return line[:len(line)-2], nil
+}
+
+func readReply(reader *bufio.Reader) (any, error) {
+	line, err := readLine(reader)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, fmt.Errorf("empty RESP line")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return &respError{msg: line[1:]}, nil
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		length, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if length < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, length+2)
+		if _, err := io.ReadFull(reader, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:length]), nil
+	case '*':
+		count, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if count < 0 {
+			return nil, nil
+		}
+		items := make([]any, count)
+		for i := range items {
+			if items[i], err = readReply(reader); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unsupported RESP type: %q", line)
+	}
+}
+
+// parseInfo parses the output of the INFO command into a map of fields.
+func parseInfo(info string) map[string]string {
+	fields := make(map[string]string)
+	for _, line := range strings.Split(info, "\r\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, found := strings.Cut(line, ":")
+		if found {
+			fields[key] = value
+		}
+	}
+	return fields
+}
@@ -0,0 +1,183 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package clustermanager
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+// Sentinels returns the sentinels monitoring the primary, if any.
+func (cluster *Cluster) Sentinels() []*Node {
+	return cluster.sentinels
+}
+
+// SentinelAddresses returns the addresses of the sentinels monitoring the primary, if any.
+func (cluster *Cluster) SentinelAddresses() []api.NodeAddress {
+	addresses := make([]api.NodeAddress, 0, len(cluster.sentinels))
+	for _, sentinel := range cluster.sentinels {
+		addresses = append(addresses, sentinel.Address)
+	}
+	return addresses
+}
+
+// MasterName returns the name under which the sentinels monitor the primary.
+func (cluster *Cluster) MasterName() string {
+	return cluster.config.MasterName
+}
+
+// Failover asks the sentinels to promote a replica, and waits until every sentinel reports the promoted replica as the
+// primary, and the promoted replica accepts writes. Returns the address of the new primary. [Cluster.Primaries] and
+// [Cluster.Replicas] keep returning the nodes by the role they were started with.
+func (cluster *Cluster) Failover(ctx context.Context) (api.NodeAddress, error) {
+	if len(cluster.sentinels) == 0 {
+		return api.NodeAddress{}, fmt.Errorf("no sentinels were started")
+	}
+	previous, err := cluster.sentinelPrimary(cluster.sentinels[0])
+	if err != nil {
+		return api.NodeAddress{}, err
+	}
+	if _, err := cluster.command(cluster.sentinels[0], "SENTINEL", "FAILOVER", cluster.config.MasterName); err != nil {
+		return api.NodeAddress{}, fmt.Errorf("failed to start a failover: %w", err)
+	}
+
+	var primary api.NodeAddress
+	err = cluster.poll(ctx, "the sentinels to promote a replica", func() (bool, error) {
+		for _, sentinel := range cluster.sentinels {
+			address, err := cluster.sentinelPrimary(sentinel)
+			if err != nil || address == previous {
+				return false, nil
+			}
+			primary = address
+		}
+		for _, node := range cluster.nodes {
+			if node.Address.Port == primary.Port {
+				role, err := cluster.command(node, "ROLE")
+				fields, ok := role.([]any)
+				return err == nil && ok && len(fields) > 0 && fields[0] == "master", nil
+			}
+		}
+		return false, fmt.Errorf("the sentinels promoted the unknown node %s:%d", primary.Host, primary.Port)
+	})
+	return primary, err
+}
+
+// sentinelPrimary returns the address of the primary reported by sentinel.
+func (cluster *Cluster) sentinelPrimary(sentinel *Node) (api.NodeAddress, error) {
+	reply, err := cluster.command(sentinel, "SENTINEL", "GET-MASTER-ADDR-BY-NAME", cluster.config.MasterName)
+	if err != nil {
+		return api.NodeAddress{}, err
+	}
+	fields, ok := reply.([]any)
+	if !ok || len(fields) != 2 {
+		return api.NodeAddress{}, fmt.Errorf("unexpected reply to SENTINEL GET-MASTER-ADDR-BY-NAME: %v", reply)
+	}
+	host, _ := fields[0].(string)
+	port, err := strconv.Atoi(fmt.Sprint(fields[1]))
+	if err != nil {
+		return api.NodeAddress{}, err
+	}
+	return api.NodeAddress{Host: host, Port: port}, nil
+}
+
+// startSentinels starts the sentinels monitoring the first node, and waits until every sentinel knows the other sentinels
+// and the replicas.
+func (cluster *Cluster) startSentinels(ctx context.Context) error {
+	if cluster.config.Sentinels == 0 {
+		return nil
+	}
+	sentinels := make([]*Node, cluster.config.Sentinels)
+	errs := make([]error, len(sentinels))
+	var wg sync.WaitGroup
+	for i := range sentinels {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sentinels[i], errs[i] = cluster.startProcess(ctx, false, cluster.sentinelArgs)
+		}(i)
+	}
+	wg.Wait()
+	for _, sentinel := range sentinels {
+		if sentinel != nil {
+			cluster.sentinels = append(cluster.sentinels, sentinel)
+		}
+	}
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	for _, sentinel := range cluster.sentinels {
+		if err := cluster.waitForSentinel(ctx, sentinel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sentinelArgs writes the configuration file of a sentinel listening on the given port, and returns its command line. The
+// sentinel rewrites the file with the state it discovers.
+func (cluster *Cluster) sentinelArgs(port int, dir string) ([]string, error) {
+	primary := cluster.nodes[0]
+	host, err := resolveIP(primary.Address.Host)
+	if err != nil {
+		return nil, err
+	}
+	name := cluster.config.MasterName
+	quorum := cluster.config.Sentinels/2 + 1
+	lines := []string{
+		"port " + strconv.Itoa(port),
+		"bind " + cluster.config.Host,
+		"dir " + dir,
+		"daemonize no",
+		"logfile " + filepath.Join(dir, "server.log"),
+		"protected-mode no",
+		fmt.Sprintf("sentinel monitor %s %s %d %d", name, host, primary.Address.Port, quorum),
+		fmt.Sprintf("sentinel down-after-milliseconds %s 1000", name),
+		fmt.Sprintf("sentinel failover-timeout %s 5000", name),
+		fmt.Sprintf("sentinel parallel-syncs %s 1", name),
+	}
+	file := filepath.Join(dir, "sentinel.conf")
+	if err := os.WriteFile(file, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+		return nil, err
+	}
+	return []string{file, "--sentinel"}, nil
+}
+
+// waitForSentinel waits until the sentinel knows the other sentinels, and sees all the replicas up.
+func (cluster *Cluster) waitForSentinel(ctx context.Context, sentinel *Node) error {
+	name := cluster.config.MasterName
+	return cluster.poll(ctx, fmt.Sprintf("sentinel %s to discover the nodes", sentinel.addressString()), func() (bool, error) {
+		others, err := cluster.command(sentinel, "SENTINEL", "SENTINELS", name)
+		if err != nil {
+			return false, nil
+		}
+		if entries, ok := others.([]any); !ok || len(entries) != len(cluster.sentinels)-1 {
+			return false, nil
+		}
+		replicas, err := cluster.command(sentinel, "SENTINEL", "REPLICAS", name)
+		if err != nil {
+			return false, nil
+		}
+		entries, ok := replicas.([]any)
+		if !ok || len(entries) != len(cluster.Replicas()) {
+			return false, nil
+		}
+		for _, entry := range entries {
+			fields, _ := entry.([]any)
+			for i := 0; i+1 < len(fields); i += 2 {
+				if fields[i] == "flags" && fields[i+1] != "slave" {
+					return false, nil
+				}
+			}
+		}
+		return true, nil
+	})
+}
@@ -0,0 +1,295 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package clustermanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+// Node is a single server process started by the cluster manager.
+type Node struct {
+	// Address is the address clients should use to connect to the node.
+	Address api.NodeAddress
+	// Primary is true if the node was started as a primary, false if it was started as a replica.
+	Primary bool
+	// ID is the cluster node ID. It is empty in standalone mode.
+	ID string
+	// Dir is the working folder of the node, which contains its log file.
+	Dir string
+
+	cmd    *exec.Cmd
+	exited chan struct{}
+}
+
+// LogFile returns the path of the node's log file.
+func (node *Node) LogFile() string {
+	return filepath.Join(node.Dir, "server.log")
+}
+
+func (node *Node) addressString() string {
+	return net.JoinHostPort(node.Address.Host, strconv.Itoa(node.Address.Port))
+}
+
+func (node *Node) hasExited() bool {
+	select {
+	case <-node.exited:
+		return true
+	default:
+		return false
+	}
+}
+
+var serverVersionRegex = regexp.MustCompile(`v=(\d+)\.(\d+)\.(\d+)`)
+
+// findServerBinary returns the path to the server executable, preferring valkey-server over redis-server.
+func findServerBinary(configured string) (string, error) {
+	if configured != "" {
+		return exec.LookPath(configured)
+	}
+	for _, name := range []string{"valkey-server", "redis-server"} {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+	return "", errors.New("neither valkey-server nor redis-server found in PATH")
+}
+
+// serverMajorVersion returns the major version reported by `<server> --version`.
+func serverMajorVersion(binary string) (int, error) {
+	output, err := exec.Command(binary, "--version").Output()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get server version: %w", err)
+	}
+	match := serverVersionRegex.FindStringSubmatch(string(output))
+	if match == nil {
+		return 0, fmt.Errorf("unable to determine server version from %q", strings.TrimSpace(string(output)))
+	}
+	return strconv.Atoi(match[1])
+}
+
+const (
+	// clusterBusPortOffset is the offset of the cluster bus port of a node from its port, when the bus port isn't set.
+	clusterBusPortOffset = 10000
+	// maxClusterPort is the highest port a cluster node can listen on, so that its bus port is a valid port.
+	maxClusterPort = 65535 - clusterBusPortOffset
+	// maxFreePortAttempts bounds the number of ports drawn from the kernel before giving up.
+	maxFreePortAttempts = 100
+)
+
+// freePort asks the kernel for a free port. In cluster mode, the port is at most maxClusterPort and its cluster bus port
+// is free as well. The ports can be taken by another process before the server binds to them, in which case startNode is
+// retried with a new port.
+func freePort(host string, clusterMode bool) (int, error) {
+	for attempt := 0; attempt < maxFreePortAttempts; attempt++ {
+		port, err := listenFreePort(host, "0")
+		if err != nil {
+			return 0, err
+		}
+		if !clusterMode {
+			return port, nil
+		}
+		if port > maxClusterPort {
+			continue
+		}
+		if _, err := listenFreePort(host, strconv.Itoa(port+clusterBusPortOffset)); err == nil {
+			return port, nil
+		}
+	}
+	return 0, fmt.Errorf("failed to find a free port with a free cluster bus port after %d attempts", maxFreePortAttempts)
+}
+
+// listenFreePort listens on the port to check that it is free, and returns it.
+func listenFreePort(host string, port string) (int, error) {
+	listener, err := net.Listen("tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return 0, err
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+// isPortConflict reports whether the server failed to start because of the port it was given: either the port or its
+// cluster bus port is already in use, or the port is too high for the cluster bus port to be valid.
+func isPortConflict(serverLog string) bool {
+	return strings.Contains(serverLog, "Address already in use") || strings.Contains(serverLog, "55535 or less")
+}
+
+// serverArgs builds the command line used to start a node on the given port.
+func (cluster *Cluster) serverArgs(port int, dir string) []string {
+	args := []string{
+		"--port", strconv.Itoa(port),
+		"--bind", cluster.config.Host,
+		"--cluster-enabled", map[bool]string{true: "yes", false: "no"}[cluster.config.ClusterMode],
+		"--dir", dir,
+		"--daemonize", "no",
+		"--logfile", filepath.Join(dir, "server.log"),
+		"--protected-mode", "no",
+		"--appendonly", "no",
+		"--save", "",
+	}
+	if cluster.config.ClusterMode && cluster.serverVersion >= 7 {
+		args = append(args, "--cluster-port", strconv.Itoa(port+clusterBusPortOffset))
+	}
+	if cluster.tlsFiles != nil {
+		args[0] = "--tls-port"
+		args = append(args,
+			"--port", "0",
+			"--tls-cluster", "yes",
+			"--tls-replication", "yes",
+			"--tls-cert-file", cluster.tlsFiles.ServerCert,
+			"--tls-key-file", cluster.tlsFiles.ServerKey,
+			"--tls-ca-cert-file", cluster.tlsFiles.CACert,
+			// Make it so clients don't have to present a certificate
+			"--tls-auth-clients", "no",
+		)
+	}
+	if cluster.serverVersion >= 7 {
+		args = append(args, "--enable-debug-command", "yes")
+	}
+	for _, module := range cluster.config.Modules {
+		args = append(args, "--loadmodule", module)
+	}
+	return append(args, cluster.config.ServerArgs...)
+}
+
+// startNode starts a single server process and waits until it accepts commands. If the chosen port is already in use, a
+// new port is picked.
+func (cluster *Cluster) startNode(ctx context.Context, primary bool) (*Node, error) {
+	return cluster.startProcess(ctx, primary, func(port int, dir string) ([]string, error) {
+		return cluster.serverArgs(port, dir), nil
+	})
+}
+
+// startProcess starts a server process with the command line returned by args for the chosen port and working folder, and
+// waits until it accepts commands.
+func (cluster *Cluster) startProcess(
+	ctx context.Context,
+	primary bool,
+	args func(port int, dir string) ([]string, error),
+) (*Node, error) {
+	const maxPortAttempts = 5
+	var lastErr error
+	for attempt := 0; attempt < maxPortAttempts; attempt++ {
+		port, err := freePort(cluster.config.Host, cluster.config.ClusterMode)
+		if err != nil {
+			return nil, err
+		}
+		dir := filepath.Join(cluster.dir, strconv.Itoa(port))
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+
+		node := &Node{
+			Address: api.NodeAddress{Host: cluster.config.Host, Port: port},
+			Primary: primary,
+			Dir:     dir,
+			exited:  make(chan struct{}),
+		}
+		nodeArgs, err := args(port, dir)
+		if err != nil {
+			return nil, err
+		}
+		node.cmd = exec.Command(cluster.serverBinary, nodeArgs...)
+		if err := node.cmd.Start(); err != nil {
+			return nil, fmt.Errorf("failed to start %s: %w", cluster.serverBinary, err)
+		}
+		go func() {
+			_ = node.cmd.Wait()
+			close(node.exited)
+		}()
+
+		lastErr = cluster.waitForNode(ctx, node)
+		if lastErr == nil {
+			return node, nil
+		}
+		cluster.stopNode(node)
+		logContent, _ := os.ReadFile(node.LogFile())
+		if !isPortConflict(string(logContent)) {
+			return nil, fmt.Errorf("%w\nserver log %s:\n%s", lastErr, node.LogFile(), logContent)
+		}
+	}
+	return nil, fmt.Errorf("failed to find a free port after %d attempts: %w", maxPortAttempts, lastErr)
+}
+
+// waitForNode polls the node with PING until it replies, the process exits or the startup timeout expires.
+func (cluster *Cluster) waitForNode(ctx context.Context, node *Node) error {
+	return cluster.poll(ctx, fmt.Sprintf("server %s to start", node.addressString()), func() (bool, error) {
+		if node.hasExited() {
+			return false, fmt.Errorf("server %s exited during startup", node.addressString())
+		}
+		reply, err := cluster.command(node, "PING")
+		if err != nil {
+			return false, nil
+		}
+		return reply == "PONG", nil
+	})
+}
+
+// stopNode asks the node to shut down and kills the process if it is still alive after a grace period.
+func (cluster *Cluster) stopNode(node *Node) {
+	if node.cmd == nil || node.cmd.Process == nil || node.hasExited() {
+		return
+	}
+	// SHUTDOWN closes the connection without a reply, so the error is expected.
+	_, _ = cluster.command(node, "SHUTDOWN", "NOSAVE")
+	select {
+	case <-node.exited:
+	case <-time.After(5 * time.Second):
+		_ = node.cmd.Process.Kill()
+		<-node.exited
+	}
+}
+
+// command opens a short-lived connection to the node and executes a single command.
+func (cluster *Cluster) command(node *Node, args ...string) (any, error) {
+	conn, err := dialResp(node.addressString(), cluster.clientTLS, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	return conn.do(args...)
+}
+
+// commandString is like command, but expects a string reply.
+func (cluster *Cluster) commandString(node *Node, args ...string) (string, error) {
+	conn, err := dialResp(node.addressString(), cluster.clientTLS, time.Second)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	return conn.doString(args...)
+}
+
+// poll calls check until it reports true, returns an error or the startup timeout expires.
+func (cluster *Cluster) poll(ctx context.Context, description string, check func() (bool, error)) error {
+	ctx, cancel := context.WithTimeout(ctx, cluster.config.StartupTimeout)
+	defer cancel()
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for %s: %w", description, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package clustermanager
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// TLSFiles holds the paths of the certificates generated for a TLS enabled deployment.
+type TLSFiles struct {
+	// CACert is the PEM encoded certificate of the authority that signed the server certificate.
+	CACert string
+	// ServerCert is the PEM encoded certificate presented by the servers.
+	ServerCert string
+	// ServerKey is the PEM encoded private key of the server certificate.
+	ServerKey string
+}
+
+// generateTLSFiles creates a self-signed certificate authority and a server certificate signed by it in the given folder.
+// The server certificate is valid for the given host, localhost and the loopback addresses.
+func generateTLSFiles(dir string, host string) (*TLSFiles, error) {
+	files := &TLSFiles{
+		CACert:     filepath.Join(dir, "ca.crt"),
+		ServerCert: filepath.Join(dir, "server.crt"),
+		ServerKey:  filepath.Join(dir, "server.key"),
+	}
+
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	notBefore := time.Now().Add(-time.Hour)
+	notAfter := notBefore.Add(10 * 365 * 24 * time.Hour)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{Organization: []string{"Valkey GLIDE Test"}, CommonName: "Certificate Authority"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+	}
+	caDer, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	caCert, err := x509.ParseCertificate(caDer)
+	if err != nil {
+		return nil, err
+	}
+
+	serverKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	serverTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{Organization: []string{"Valkey GLIDE Test"}, CommonName: "Generic-cert"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		// The servers use the same certificate to connect to each other for replication and the cluster bus.
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:    []string{"localhost"},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		serverTemplate.IPAddresses = append(serverTemplate.IPAddresses, ip)
+	} else if host != "localhost" {
+		serverTemplate.DNSNames = append(serverTemplate.DNSNames, host)
+	}
+	serverDer, err := x509.CreateCertificate(rand.Reader, serverTemplate, caCert, &serverKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writePem(files.CACert, "CERTIFICATE", caDer); err != nil {
+		return nil, err
+	}
+	if err := writePem(files.ServerCert, "CERTIFICATE", serverDer); err != nil {
+		return nil, err
+	}
+	if err := writePem(files.ServerKey, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(serverKey)); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func writePem(path string, blockType string, der []byte) error {
+	return os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600)
+}
+
+// clientTLSConfig returns a TLS configuration which trusts the generated certificate authority.
+func (files *TLSFiles) clientTLSConfig() (*tls.Config, error) {
+	caPem, err := os.ReadFile(files.CACert)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM(caPem)
+	return &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}, nil
+}
@@ -0,0 +1,93 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/integTest/clustermanager"
+)
+
+// BenchmarkCommands measures the time and the allocations of single commands sent to a standalone server, given with
+// -standalone-endpoints or started for the benchmark. For example:
+//
+//	go test ./integTest -run '^$' -bench BenchmarkCommands -benchmem
+func BenchmarkCommands(b *testing.B) {
+	var address api.NodeAddress
+	if *standaloneHosts != "" {
+		address = parseBenchmarkHost(b, *standaloneHosts)
+	} else {
+		address = clustermanager.StartForTest(b, clustermanager.Config{TLS: *tls}).Addresses()[0]
+	}
+	client, err := api.NewGlideClient(
+		context.Background(),
+		api.NewGlideClientConfiguration().WithAddress(&address).WithUseTLS(*tls),
+	)
+	if err != nil {
+		b.Fatalf("failed to create the client: %s", err.Error())
+	}
+	defer client.Close()
+
+	ctx := context.Background()
+	keys := make([]string, 10)
+	fields := make(map[string]string, 10)
+	for i := range keys {
+		keys[i] = "{bench}key" + strconv.Itoa(i)
+		fields["field"+strconv.Itoa(i)] = "value" + strconv.Itoa(i)
+		if _, err := client.Set(ctx, keys[i], "value"+strconv.Itoa(i)); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if _, err := client.HSet(ctx, "{bench}hash", fields); err != nil {
+		b.Fatal(err)
+	}
+
+	b.Run("Get", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := client.Get(ctx, keys[0]); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("Set", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := client.Set(ctx, keys[0], "value"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("MGet", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := client.MGet(ctx, keys); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("HGetAll", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := client.HGetAll(ctx, "{bench}hash"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func parseBenchmarkHost(b *testing.B, addresses string) api.NodeAddress {
+	host, port, found := strings.Cut(strings.Split(addresses, ",")[0], ":")
+	if !found {
+		b.Fatalf("invalid address %s", addresses)
+	}
+	portNumber, err := strconv.Atoi(port)
+	if err != nil {
+		b.Fatalf("failed to parse port from string %s: %s", port, err.Error())
+	}
+	return api.NodeAddress{Host: host, Port: portNumber}
+}
@@ -0,0 +1,120 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+func (suite *GlideTestSuite) TestConnectionsPerNode_SpreadsCommands() {
+	for _, balancing := range []api.ConnectionBalancing{api.RoundRobin, api.LeastInflight} {
+		name := uuid.NewString()
+		client := suite.client(suite.defaultClientConfig().
+			WithConnectionsPerNode(3).
+			WithConnectionBalancing(balancing).
+			WithClientName(name))
+
+		var wg sync.WaitGroup
+		for i := 0; i < 100; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				key := uuid.NewString()
+				suite.verifyOK(client.Set(context.Background(), key, "value"))
+				result, err := client.Get(context.Background(), key)
+				assert.NoError(suite.T(), err)
+				assert.Equal(suite.T(), "value", result.Value())
+			}()
+		}
+		wg.Wait()
+
+		// Every connection of the client was opened, and served commands.
+		list, err := client.CustomCommand(context.Background(), []string{"CLIENT", "LIST"})
+		assert.NoError(suite.T(), err)
+		connections := 0
+		for _, line := range strings.Split(list.(string), "\n") {
+			if strings.Contains(line, " name="+name+" ") {
+				connections++
+				assert.Regexp(suite.T(), ` cmd=(get|set|client\|list) `, line)
+			}
+		}
+		assert.Equal(suite.T(), 3, connections)
+		client.Close()
+	}
+}
+
+func (suite *GlideTestSuite) TestConnectionsPerNode_SelectAppliesToAllConnections() {
+	client := suite.client(suite.defaultClientConfig().WithConnectionsPerNode(3))
+	key := uuid.NewString()
+
+	suite.verifyOK(client.Select(context.Background(), 1))
+	for i := 0; i < 6; i++ {
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+	}
+	suite.verifyOK(client.Select(context.Background(), 0))
+	for i := 0; i < 6; i++ {
+		result, err := client.Get(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		assert.True(suite.T(), result.IsNil())
+	}
+}
+
+func (suite *GlideTestSuite) TestConnectionsPerNode_PasswordAppliesToAllConnections() {
+	adminClient := suite.defaultClient()
+	client := suite.client(suite.defaultClientConfig().WithConnectionsPerNode(3))
+	pwd := uuid.NewString()
+	ping := func() {
+		for i := 0; i < 6; i++ {
+			_, err := client.Ping(context.Background())
+			assert.NoError(suite.T(), err)
+		}
+	}
+	reconnect := func(password string) {
+		_, err := adminClient.CustomCommand(context.Background(), []string{"CONFIG", "SET", "requirepass", password})
+		assert.NoError(suite.T(), err)
+		_, err = adminClient.CustomCommand(context.Background(), []string{"CLIENT", "KILL", "TYPE", "NORMAL"})
+		assert.NoError(suite.T(), err)
+	}
+	defer adminClient.CustomCommand(context.Background(), []string{"CONFIG", "SET", "requirepass", ""})
+
+	// Every connection reconnects with the new password.
+	suite.verifyOK(client.UpdateConnectionPassword(context.Background(), pwd, false))
+	reconnect(pwd)
+	ping()
+
+	// Every connection reconnects without password.
+	suite.verifyOK(client.ResetConnectionPassword(context.Background()))
+	reconnect("")
+	ping()
+}
+
+func (suite *GlideTestSuite) TestConnectionsPerNode_BlockingCommandIsUnblocked() {
+	clients := []api.BaseClient{
+		suite.client(suite.defaultClientConfig().WithConnectionsPerNode(3)),
+		suite.clusterClient(suite.defaultClusterClientConfig().WithConnectionsPerNode(3)),
+	}
+	suite.runWithClients(clients, func(client api.BaseClient) {
+		key := uuid.NewString()
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		// The connection ID used to unblock the command is fetched on the connection of the command.
+		_, err := client.BLPop(ctx, []string{key}, 0)
+		assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+
+		ctx, cancel = context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+		_, err = client.LPush(ctx, key, []string{"element"})
+		assert.NoError(suite.T(), err)
+		length, err := client.LLen(ctx, key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), int64(1), length)
+	})
+}
@@ -6,8 +6,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
 	"github.com/valkey-io/valkey-glide/go/api/options"
 )
 
@@ -99,3 +101,58 @@ func (suite *GlideTestSuite) TestContext_CancelWithScan() {
 		assert.Equal(suite.T(), context.Canceled.Error(), err.Error())
 	})
 }
+
+// slowScript keeps the server busy for 300 milliseconds.
+const slowScript = `local start = redis.call('TIME')
+while true do
+	local now = redis.call('TIME')
+	if (now[1] - start[1]) * 1000000 + now[2] - start[2] > 300000 then
+		return 'done'
+	end
+end`
+
+// TestContext_DeadlineReplacesRequestTimeout tests that the deadline of the
+// context is used as the request timeout instead of the client's one
+func (suite *GlideTestSuite) TestContext_DeadlineReplacesRequestTimeout() {
+	clients := []api.BaseClient{
+		suite.client(suite.defaultClientConfig().WithRequestTimeout(100 * time.Millisecond)),
+		suite.clusterClient(suite.defaultClusterClientConfig().WithRequestTimeout(100 * time.Millisecond)),
+	}
+	suite.runWithClients(clients, func(client api.BaseClient) {
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		var err error
+		switch c := client.(type) {
+		case api.GlideClientCommands:
+			_, err = c.CustomCommand(ctx, []string{"EVAL", slowScript, "0"})
+		case api.GlideClusterClientCommands:
+			_, err = c.CustomCommand(ctx, []string{"EVAL", slowScript, "0"})
+		default:
+			suite.T().Fatalf("Unexpected client type: %T", client)
+		}
+		assert.NoError(suite.T(), err)
+	})
+}
+
+// TestContext_DeadlineExceededDuringExecution tests that a command which
+// doesn't complete before the deadline of its context is cancelled in the core
+func (suite *GlideTestSuite) TestContext_DeadlineExceededDuringExecution() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := client.BLPop(ctx, []string{uuid.NewString()}, 10.0)
+		assert.Less(suite.T(), time.Since(start), time.Second)
+		assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+		assert.ErrorAs(suite.T(), err, new(*errors.TimeoutError))
+
+		// The client keeps serving commands after the cancellation.
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+		result, err := client.Get(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", result.Value())
+	})
+}
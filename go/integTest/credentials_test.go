@@ -0,0 +1,72 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+func (suite *GlideTestSuite) TestCredentialsProvider_RefreshesToken() {
+	admin := suite.defaultClient()
+	user := "user-" + uuid.NewString()
+	_, err := admin.CustomCommand(context.Background(), []string{"ACL", "SETUSER", user, "on", "~*", "&*", "+@all"})
+	assert.NoError(suite.T(), err)
+	defer admin.CustomCommand(context.Background(), []string{"ACL", "DELUSER", user})
+
+	// Like a short-lived token, every password is added to the user when issued.
+	var mu sync.Mutex
+	var tokens []string
+	provider := api.CredentialsProviderFunc(func(ctx context.Context) (api.Credentials, error) {
+		token := uuid.NewString()
+		if _, err := admin.CustomCommand(ctx, []string{"ACL", "SETUSER", user, ">" + token}); err != nil {
+			return api.Credentials{}, err
+		}
+		mu.Lock()
+		tokens = append(tokens, token)
+		mu.Unlock()
+		return api.Credentials{Username: user, Password: token, ExpiresAt: time.Now().Add(time.Second)}, nil
+	})
+	client := suite.client(
+		suite.defaultClientConfig().WithCredentials(api.NewServerCredentialsWithProvider(provider)),
+	)
+	whoami, err := client.CustomCommand(context.Background(), []string{"ACL", "WHOAMI"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), user, whoami)
+
+	assert.Eventually(suite.T(), func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(tokens) >= 3
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// Once the first token is revoked, the client reconnects with a refreshed one.
+	mu.Lock()
+	first := tokens[0]
+	mu.Unlock()
+	_, err = admin.CustomCommand(context.Background(), []string{"ACL", "SETUSER", user, "<" + first})
+	assert.NoError(suite.T(), err)
+	_, err = admin.CustomCommand(context.Background(), []string{"CLIENT", "KILL", "USER", user})
+	assert.NoError(suite.T(), err)
+	assert.Eventually(suite.T(), func() bool {
+		result, err := client.CustomCommand(context.Background(), []string{"ACL", "WHOAMI"})
+		return err == nil && fmt.Sprint(result) == user
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func (suite *GlideTestSuite) TestCredentialsProvider_ConnectError() {
+	provider := api.CredentialsProviderFunc(func(ctx context.Context) (api.Credentials, error) {
+		return api.Credentials{}, fmt.Errorf("token service unavailable")
+	})
+	_, err := api.NewGlideClient(
+		context.Background(),
+		suite.defaultClientConfig().WithCredentials(api.NewServerCredentialsWithProvider(provider)),
+	)
+	assert.ErrorContains(suite.T(), err, "token service unavailable")
+}
@@ -0,0 +1,106 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *GlideTestSuite) TestDedicated_BlockingPopDoesNotBlockClient() {
+	client := suite.defaultClient()
+	dedicated, err := client.Dedicated(context.Background())
+	assert.NoError(suite.T(), err)
+	defer dedicated.Close()
+
+	key := uuid.NewString()
+	popped := make(chan []string, 1)
+	go func() {
+		result, err := dedicated.BLPop(context.Background(), []string{key}, 5)
+		assert.NoError(suite.T(), err)
+		popped <- result
+	}()
+
+	// The client keeps serving commands while the dedicated connection is blocked.
+	time.Sleep(100 * time.Millisecond)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = client.LPush(ctx, key, []string{"element"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []string{key, "element"}, <-popped)
+}
+
+func (suite *GlideTestSuite) TestDedicated_StateIsResetOnClose() {
+	client := suite.client(suite.defaultClientConfig().WithDedicatedPoolSize(1))
+	key := uuid.NewString()
+
+	dedicated, err := client.Dedicated(context.Background())
+	assert.NoError(suite.T(), err)
+	suite.verifyOK(dedicated.Select(context.Background(), 1))
+	suite.verifyOK(dedicated.Set(context.Background(), key, "value"))
+	dedicated.Close()
+
+	// The client and the next dedicated connection, which reuses the same connection, use the configured database.
+	result, err := client.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsNil())
+	dedicated, err = client.Dedicated(context.Background())
+	assert.NoError(suite.T(), err)
+	defer dedicated.Close()
+	result, err = dedicated.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), result.IsNil())
+
+	suite.verifyOK(dedicated.Select(context.Background(), 1))
+	_, err = dedicated.Del(context.Background(), []string{key})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestDedicated_WatchMulti() {
+	client := suite.defaultClient()
+	dedicated, err := client.Dedicated(context.Background())
+	assert.NoError(suite.T(), err)
+	defer dedicated.Close()
+
+	key := uuid.NewString()
+	for _, args := range [][]string{{"WATCH", key}, {"MULTI"}, {"SET", key, "value"}} {
+		_, err := dedicated.CustomCommand(context.Background(), args)
+		assert.NoError(suite.T(), err)
+	}
+	result, err := dedicated.CustomCommand(context.Background(), []string{"EXEC"})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []interface{}{"OK"}, result)
+
+	// A write from another connection aborts the transaction of the dedicated connection.
+	for _, args := range [][]string{{"WATCH", key}, {"MULTI"}, {"SET", key, "other"}} {
+		_, err := dedicated.CustomCommand(context.Background(), args)
+		assert.NoError(suite.T(), err)
+	}
+	suite.verifyOK(client.Set(context.Background(), key, "concurrent"))
+	result, err = dedicated.CustomCommand(context.Background(), []string{"EXEC"})
+	assert.NoError(suite.T(), err)
+	assert.Nil(suite.T(), result)
+}
+
+func (suite *GlideTestSuite) TestDedicated_PoolIsBounded() {
+	client := suite.client(suite.defaultClientConfig().WithDedicatedPoolSize(1))
+	first, err := client.Dedicated(context.Background())
+	assert.NoError(suite.T(), err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.Dedicated(ctx)
+	assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+
+	first.Close()
+	second, err := client.Dedicated(context.Background())
+	assert.NoError(suite.T(), err)
+	second.Close()
+
+	client.Close()
+	_, err = client.Dedicated(context.Background())
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,50 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	goErrors "errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func (suite *GlideTestSuite) TestServerError_WrongType() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+		_, err := client.LPush(context.Background(), key, []string{"element"})
+		assert.ErrorIs(suite.T(), err, errors.ErrWrongType)
+		assert.NotErrorIs(suite.T(), err, errors.ErrNoGroup)
+
+		var requestErr *errors.RequestError
+		assert.True(suite.T(), goErrors.As(err, &requestErr))
+		assert.Equal(suite.T(), "WRONGTYPE", requestErr.Code())
+	})
+}
+
+func (suite *GlideTestSuite) TestServerError_NoGroup() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := uuid.NewString()
+		_, err := client.XAdd(context.Background(), key, [][]string{{"field", "value"}})
+		assert.NoError(suite.T(), err)
+
+		_, err = client.XReadGroup(context.Background(), uuid.NewString(), "consumer", map[string]string{key: ">"})
+		assert.ErrorIs(suite.T(), err, errors.ErrNoGroup)
+	})
+}
+
+func (suite *GlideTestSuite) TestServerError_GenericErrorIsRequestError() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+		_, err := client.Incr(context.Background(), key)
+		assert.IsType(suite.T(), &errors.RequestError{}, err)
+		assert.Empty(suite.T(), err.(*errors.RequestError).Code())
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/faults"
+)
+
+// injectFaults wraps client with injector, and detaches the injector from client when the test ends.
+func (suite *GlideTestSuite) injectFaults(client api.BaseClient, injector *faults.Injector) api.BaseClient {
+	wrapped, err := faults.Wrap(client, injector)
+	require.NoError(suite.T(), err)
+	suite.T().Cleanup(wrapped.(interface{ Detach() }).Detach)
+	return wrapped
+}
+
+func (suite *GlideTestSuite) TestFaultInjection_FailNextHGet() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := uuid.New().String()
+		_, err := client.HSet(context.Background(), key, map[string]string{"field": "value"})
+		assert.NoError(suite.T(), err)
+
+		injector := faults.NewInjector()
+		client = suite.injectFaults(client, injector)
+
+		_, err = injector.AddRule(faults.Rule{
+			Commands: []string{"HGet"},
+			Times:    2,
+			Err:      errors.NewTimeoutError("injected timeout"),
+		})
+		assert.NoError(suite.T(), err)
+
+		for i := 0; i < 2; i++ {
+			_, err = client.HGet(context.Background(), key, "field")
+			assert.IsType(suite.T(), &errors.TimeoutError{}, err)
+		}
+		result, err := client.HGet(context.Background(), key, "field")
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", result.Value())
+	})
+}
+
+func (suite *GlideTestSuite) TestFaultInjection_KeyPatternAndCustomCommand() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		prefix := uuid.New().String()
+		injector := faults.NewInjector()
+		client = suite.injectFaults(client, injector)
+
+		_, err := injector.AddRule(faults.Rule{
+			KeyPattern: prefix + ":*",
+			Err:        &errors.ConnectionError{Msg: "injected disconnect"},
+		})
+		assert.NoError(suite.T(), err)
+
+		_, err = client.Set(context.Background(), prefix+":1", "value")
+		assert.IsType(suite.T(), &errors.ConnectionError{}, err)
+		_, err = client.Set(context.Background(), prefix+"-other", "value")
+		assert.NoError(suite.T(), err)
+
+		switch c := client.(type) {
+		case api.GlideClientCommands:
+			_, err = c.CustomCommand(context.Background(), []string{"GET", prefix + ":1"})
+		case api.GlideClusterClientCommands:
+			_, err = c.CustomCommand(context.Background(), []string{"GET", prefix + ":1"})
+		}
+		assert.IsType(suite.T(), &errors.ConnectionError{}, err)
+	})
+}
+
+func (suite *GlideTestSuite) TestFaultInjection_Redirect() {
+	injector := faults.NewInjector()
+	client, err := faults.NewClusterClient(suite.defaultClusterClient(), injector)
+	require.NoError(suite.T(), err)
+	defer client.Detach()
+	key := uuid.New().String()
+
+	_, err = injector.AddRule(faults.Rule{
+		Commands:   []string{"Get"},
+		Times:      1,
+		Redirect:   faults.RedirectMoved,
+		RedirectTo: suite.clusterHosts[0].Host + ":" + strconv.Itoa(suite.clusterHosts[0].Port),
+	})
+	assert.NoError(suite.T(), err)
+
+	_, err = client.Get(context.Background(), key)
+	assert.ErrorIs(suite.T(), err, errors.ErrMoved)
+	assert.IsType(suite.T(), &errors.RequestError{}, err)
+	_, err = client.Get(context.Background(), key)
+	assert.NoError(suite.T(), err)
+}
@@ -0,0 +1,58 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+)
+
+func (suite *GlideTestSuite) TestFireAndForget_Flush() {
+	suite.runWithDefaultClients(func(client api.BaseClient) {
+		key := uuid.NewString()
+		for i := 0; i < 1000; i++ {
+			assert.NoError(suite.T(), client.FireAndForget().IncrBy(context.Background(), key, 2))
+		}
+		assert.NoError(suite.T(), client.FireAndForget().Flush(context.Background()))
+
+		result, err := client.Get(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "2000", result.Value())
+	})
+}
+
+func (suite *GlideTestSuite) TestFireAndForget_ErrorHandler() {
+	errs := make(chan error, 10)
+	handler := func(err error) { errs <- err }
+	clients := []api.BaseClient{
+		suite.client(suite.defaultClientConfig().WithFireAndForgetErrorHandler(handler)),
+		suite.clusterClient(suite.defaultClusterClientConfig().WithFireAndForgetErrorHandler(handler)),
+	}
+	suite.runWithClients(clients, func(client api.BaseClient) {
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+		assert.NoError(suite.T(), client.FireAndForget().HIncrBy(context.Background(), key, "field", 1))
+		assert.NoError(suite.T(), client.FireAndForget().Flush(context.Background()))
+		select {
+		case err := <-errs:
+			assert.IsType(suite.T(), &errors.RequestError{}, err)
+		case <-time.After(time.Second):
+			assert.Fail(suite.T(), "the error handler wasn't called")
+		}
+	})
+}
+
+func (suite *GlideTestSuite) TestFireAndForget_ClosedClient() {
+	client := suite.client(suite.defaultClientConfig())
+	client.Close()
+
+	err := client.FireAndForget().PfAdd(context.Background(), uuid.NewString(), []string{"element"})
+	assert.IsType(suite.T(), &errors.ClosingError{}, err)
+	assert.IsType(suite.T(), &errors.ClosingError{}, client.FireAndForget().Flush(context.Background()))
+}
@@ -4,13 +4,9 @@ package integTest
 
 import (
 	"context"
-	"errors"
 	"flag"
 	"fmt"
-	"log"
 	"math"
-	"os"
-	"os/exec"
 	"strconv"
 	"strings"
 	"sync"
@@ -24,6 +20,7 @@ import (
 	"github.com/valkey-io/valkey-glide/go/api"
 	"github.com/valkey-io/valkey-glide/go/api/config"
 	"github.com/valkey-io/valkey-glide/go/api/options"
+	"github.com/valkey-io/valkey-glide/go/integTest/clustermanager"
 )
 
 type ClientTypeFlag uint
@@ -60,22 +57,7 @@ var (
 )
 
 func (suite *GlideTestSuite) SetupSuite() {
-	// Stop cluster in case previous test run was interrupted or crashed and didn't stop.
-	// If an error occurs, we ignore it in case the servers actually were stopped before running this.
-	runClusterManager(suite, []string{"stop", "--prefix", "cluster"}, true)
-
-	// Delete dirs if stop failed due to https://github.com/valkey-io/valkey-glide/issues/849
-	err := os.RemoveAll("../../utils/clusters")
-	if err != nil && !os.IsNotExist(err) {
-		log.Fatal(err)
-	}
-
-	cmd := []string{}
-	suite.tls = false
-	if *tls {
-		cmd = []string{"--tls"}
-		suite.tls = true
-	}
+	suite.tls = *tls
 	suite.T().Logf("TLS = %t", suite.tls)
 
 	// Note: code does not start standalone if cluster hosts are given and vice versa
@@ -90,13 +72,15 @@ func (suite *GlideTestSuite) SetupSuite() {
 		startServer = false
 	}
 	if startServer {
-		// Start standalone instance
-		clusterManagerOutput := runClusterManager(suite, append(cmd, "start", "-r", "3"), false)
-		suite.standaloneHosts = extractAddresses(suite, clusterManagerOutput)
+		// Servers are stopped by the cleanup registered on the suite's test, even if the suite fails.
+		standalone := clustermanager.StartForTest(suite.T(), clustermanager.Config{Replicas: 3, TLS: suite.tls})
+		suite.standaloneHosts = standalone.Addresses()
 
-		// Start cluster
-		clusterManagerOutput = runClusterManager(suite, append(cmd, "start", "--cluster-mode", "-r", "3"), false)
-		suite.clusterHosts = extractAddresses(suite, clusterManagerOutput)
+		cluster := clustermanager.StartForTest(
+			suite.T(),
+			clustermanager.Config{ClusterMode: true, Replicas: 3, TLS: suite.tls},
+		)
+		suite.clusterHosts = cluster.Addresses()
 	}
 
 	suite.T().Logf("Standalone hosts = %s", fmt.Sprint(suite.standaloneHosts))
@@ -123,46 +107,6 @@ func parseHosts(suite *GlideTestSuite, addresses string) []api.NodeAddress {
 	return result
 }
 
-func extractAddresses(suite *GlideTestSuite, output string) []api.NodeAddress {
-	for _, line := range strings.Split(output, "\n") {
-		if !strings.HasPrefix(line, "CLUSTER_NODES=") {
-			continue
-		}
-
-		addresses := strings.Split(line, "=")[1]
-		return parseHosts(suite, addresses)
-	}
-
-	suite.T().Fatalf("Failed to parse port from cluster_manager.py output")
-	return []api.NodeAddress{}
-}
-
-func runClusterManager(suite *GlideTestSuite, args []string, ignoreExitCode bool) string {
-	pythonArgs := append([]string{"../../utils/cluster_manager.py"}, args...)
-	output, err := exec.Command("python3", pythonArgs...).CombinedOutput()
-	if len(output) > 0 && !ignoreExitCode {
-		suite.T().Logf("cluster_manager.py output:\n====\n%s\n====\n", string(output))
-	}
-
-	if err != nil {
-		var exitError *exec.ExitError
-		isExitError := errors.As(err, &exitError)
-		if !isExitError {
-			suite.T().Fatalf("Unexpected error while executing cluster_manager.py: %s", err.Error())
-		}
-
-		if len(exitError.Stderr) > 0 {
-			suite.T().Logf("cluster_manager.py stderr:\n====\n%s\n====\n", string(exitError.Stderr))
-		}
-
-		if !ignoreExitCode {
-			suite.T().Fatalf("cluster_manager.py script failed: %s", exitError.Error())
-		}
-	}
-
-	return string(output)
-}
-
 func getServerVersion(suite *GlideTestSuite) string {
 	var err error = nil
 	if len(suite.standaloneHosts) > 0 {
@@ -235,10 +179,6 @@ func TestGlideTestSuite(t *testing.T) {
 	suite.Run(t, new(GlideTestSuite))
 }
 
-func (suite *GlideTestSuite) TearDownSuite() {
-	runClusterManager(suite, []string{"stop", "--prefix", "cluster", "--keep-folder"}, true)
-}
-
 func (suite *GlideTestSuite) TearDownTest() {
 	for _, client := range suite.clients {
 		client.Close()
@@ -0,0 +1,37 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/faults"
+)
+
+func (suite *GlideTestSuite) TestHedging_SlowReadIsHedged() {
+	hedging := api.NewHedgingConfig(20 * time.Millisecond).WithMaxExtraLoad(1)
+	clients := []api.BaseClient{
+		suite.client(suite.defaultClientConfig().WithHedging(hedging)),
+		suite.clusterClient(suite.defaultClusterClientConfig().WithReadFrom(api.PreferReplica).WithHedging(hedging)),
+	}
+	suite.runWithClients(clients, func(client api.BaseClient) {
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+		injector := faults.NewInjector()
+		client = suite.injectFaults(client, injector)
+		id, err := injector.AddRule(faults.Rule{Commands: []string{"Get"}, Times: 1, Latency: 5 * time.Second})
+		assert.NoError(suite.T(), err)
+
+		start := time.Now()
+		result, err := client.Get(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", result.Value())
+		assert.Less(suite.T(), time.Since(start), time.Second)
+		assert.Equal(suite.T(), 1, injector.Applied(id))
+	})
+}
@@ -0,0 +1,39 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+)
+
+func (suite *GlideTestSuite) TestLowestLatency_MeasuresAllNodes() {
+	client := suite.clusterClient(suite.defaultClusterClientConfig().WithReadFrom(api.LowestLatency))
+
+	// The test cluster has several shards with replicas, all of which are measured.
+	assert.Eventually(suite.T(), func() bool {
+		return len(client.NodeLatencies()) > len(suite.clusterHosts)
+	}, 10*time.Second, 100*time.Millisecond)
+	for node, latency := range client.NodeLatencies() {
+		assert.Positive(suite.T(), latency, node)
+	}
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	assert.Eventually(suite.T(), func() bool {
+		result, err := client.Get(context.Background(), key)
+		return err == nil && result.Value() == "value"
+	}, 5*time.Second, 100*time.Millisecond)
+}
+
+func (suite *GlideTestSuite) TestLowestLatency_DisabledForOtherStrategies() {
+	client := suite.defaultClusterClient()
+	assert.Nil(suite.T(), client.NodeLatencies())
+
+	_, err := api.NewGlideClient(context.Background(), suite.defaultClientConfig().WithReadFrom(api.LowestLatency))
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,110 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/config"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/options"
+)
+
+func (suite *GlideTestSuite) TestReadFromOverride_ReadYourWrites() {
+	client := suite.clusterClient(suite.defaultClusterClientConfig().WithReadFrom(api.PreferReplica))
+	ctx := api.WithReadFrom(context.Background(), api.Primary)
+
+	for i := 0; i < 10; i++ {
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+		result, err := client.Get(ctx, key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", result.Value())
+	}
+}
+
+func (suite *GlideTestSuite) TestReadFromOverride_CustomCommandWithRoute() {
+	client := suite.clusterClient(suite.defaultClusterClientConfig().WithReadFrom(api.PreferReplica))
+	key := uuid.NewString()
+	role := func(ctx context.Context, route config.Route) string {
+		result, err := client.CustomCommandWithRoute(ctx, []string{"ROLE"}, route)
+		assert.NoError(suite.T(), err)
+		return result.SingleValue().([]interface{})[0].(string)
+	}
+
+	primaryCtx := api.WithReadFrom(context.Background(), api.Primary)
+	assert.Equal(suite.T(), "master", role(primaryCtx, config.NewSlotKeyRoute(config.SlotTypeReplica, key)))
+
+	replicaCtx := api.WithReadFrom(context.Background(), api.PreferReplica)
+	assert.Equal(suite.T(), "slave", role(replicaCtx, config.NewSlotKeyRoute(config.SlotTypePrimary, key)))
+}
+
+func (suite *GlideTestSuite) TestReadFromOverride_PrimaryConfiguredCluster() {
+	client := suite.defaultClusterClient()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+	result, err := client.Get(api.WithReadFrom(context.Background(), api.Primary), key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+
+	// The replicas would redirect the reads to the primary, since the client doesn't send them READONLY.
+	for _, readFrom := range []api.ReadFrom{api.PreferReplica, api.AzAffinity, api.AzAffinityReplicaAndPrimary} {
+		_, err = client.Get(api.WithReadFrom(context.Background(), readFrom), key)
+		assert.ErrorAs(suite.T(), err, new(*errors.RequestError))
+	}
+	_, err = client.CustomCommandWithRoute(api.WithReadFrom(context.Background(), api.PreferReplica), []string{"ROLE"},
+		config.NewSlotKeyRoute(config.SlotTypePrimary, key))
+	assert.ErrorAs(suite.T(), err, new(*errors.RequestError))
+
+	// Writes are not affected.
+	suite.verifyOK(client.Set(api.WithReadFrom(context.Background(), api.PreferReplica), key, "value"))
+}
+
+func (suite *GlideTestSuite) TestReadFromOverride_Standalone() {
+	role := func(client api.GlideClientCommands, ctx context.Context) string {
+		info, err := client.InfoWithOptions(ctx, options.InfoOptions{Sections: []options.Section{options.Replication}})
+		assert.NoError(suite.T(), err)
+		for _, line := range strings.Split(info, "\r\n") {
+			if role, found := strings.CutPrefix(line, "role:"); found {
+				return role
+			}
+		}
+		return ""
+	}
+	clientConfig := func(readFrom api.ReadFrom) *api.GlideClientConfiguration {
+		clientConfig := api.NewGlideClientConfiguration().WithUseTLS(suite.tls).WithReadFrom(readFrom)
+		for i := range suite.standaloneHosts {
+			clientConfig.WithAddress(&suite.standaloneHosts[i])
+		}
+		return clientConfig
+	}
+	primaryCtx := api.WithReadFrom(context.Background(), api.Primary)
+	replicaCtx := api.WithReadFrom(context.Background(), api.PreferReplica)
+
+	// A client configured to read from the primary reads from a replica with an override, and the other way around.
+	client := suite.client(clientConfig(api.Primary))
+	assert.Equal(suite.T(), "master", role(client, context.Background()))
+	assert.Equal(suite.T(), "slave", role(client, replicaCtx))
+	assert.Equal(suite.T(), "master", role(client, primaryCtx))
+
+	client = suite.client(clientConfig(api.PreferReplica))
+	assert.Equal(suite.T(), "slave", role(client, context.Background()))
+	assert.Equal(suite.T(), "master", role(client, primaryCtx))
+
+	// The reads executed with an override are served from the database selected by the client.
+	key := uuid.NewString()
+	suite.verifyOK(client.Select(context.Background(), 4))
+	suite.verifyOK(client.Set(context.Background(), key, "value"))
+	result, err := client.Get(primaryCtx, key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", result.Value())
+
+	// LowestLatency is only supported in cluster mode.
+	_, err = client.Get(api.WithReadFrom(context.Background(), api.LowestLatency), key)
+	assert.ErrorAs(suite.T(), err, new(*errors.RequestError))
+}
@@ -0,0 +1,76 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/api/faults"
+)
+
+func (suite *GlideTestSuite) retryClients(policy *api.RetryPolicy) []api.BaseClient {
+	return []api.BaseClient{
+		suite.client(suite.defaultClientConfig().WithRetryPolicy(policy)),
+		suite.clusterClient(suite.defaultClusterClientConfig().WithRetryPolicy(policy)),
+	}
+}
+
+func (suite *GlideTestSuite) TestRetryPolicy_RetriesReadOnlyCommands() {
+	policy := api.NewRetryPolicy(3).WithBackoff(time.Millisecond, 10*time.Millisecond)
+	suite.runWithClients(suite.retryClients(policy), func(client api.BaseClient) {
+		key := uuid.NewString()
+		suite.verifyOK(client.Set(context.Background(), key, "value"))
+
+		injector := faults.NewInjector()
+		client = suite.injectFaults(client, injector)
+		id, err := injector.AddRule(faults.Rule{
+			Commands: []string{"Get"},
+			Times:    2,
+			Err:      errors.NewTimeoutError("injected timeout"),
+		})
+		assert.NoError(suite.T(), err)
+
+		result, err := client.Get(context.Background(), key)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), "value", result.Value())
+		assert.Equal(suite.T(), 2, injector.Applied(id))
+
+		_, err = injector.AddRule(faults.Rule{Commands: []string{"Get"}, Err: errors.NewDisconnectError("injected")})
+		assert.NoError(suite.T(), err)
+		_, err = client.Get(context.Background(), key)
+		var retryErr *errors.RetryError
+		assert.ErrorAs(suite.T(), err, &retryErr)
+		assert.Equal(suite.T(), 3, retryErr.Attempts)
+		assert.ErrorAs(suite.T(), err, new(*errors.DisconnectError))
+	})
+}
+
+func (suite *GlideTestSuite) TestRetryPolicy_DoesNotRetryNonIdempotentCommands() {
+	policy := api.NewRetryPolicy(3).WithBackoff(time.Millisecond, 10*time.Millisecond)
+	suite.runWithClients(suite.retryClients(policy), func(client api.BaseClient) {
+		key := uuid.NewString()
+		injector := faults.NewInjector()
+		client = suite.injectFaults(client, injector)
+		id, err := injector.AddRule(faults.Rule{
+			Commands: []string{"Incr", "Set"},
+			Times:    1,
+			Err:      errors.NewTimeoutError("injected timeout"),
+		})
+		assert.NoError(suite.T(), err)
+
+		_, err = client.Incr(context.Background(), key)
+		assert.IsType(suite.T(), &errors.TimeoutError{}, err)
+		assert.Equal(suite.T(), 1, injector.Applied(id))
+
+		// SET with a fixed value is safe to retry when the caller opts in.
+		id, err = injector.AddRule(faults.Rule{Commands: []string{"Set"}, Times: 1, Err: errors.NewTimeoutError("injected")})
+		assert.NoError(suite.T(), err)
+		suite.verifyOK(client.Set(api.WithRetry(context.Background(), true), key, "value"))
+		assert.Equal(suite.T(), 1, injector.Applied(id))
+	})
+}
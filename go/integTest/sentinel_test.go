@@ -0,0 +1,115 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/integTest/clustermanager"
+)
+
+// startSentinelDeployment starts a primary with a replica, monitored by three sentinels, and returns it with a sentinel
+// configuration for it.
+func (suite *GlideTestSuite) startSentinelDeployment() (*clustermanager.Cluster, *api.SentinelConfig) {
+	if suite.tls {
+		suite.T().Skip("Sentinels are only started without TLS")
+	}
+	deployment := clustermanager.StartForTest(suite.T(), clustermanager.Config{Replicas: 1, Sentinels: 3})
+	sentinel := api.NewSentinelConfig(deployment.MasterName())
+	for _, address := range deployment.SentinelAddresses() {
+		sentinel.WithSentinel(&address)
+	}
+	return deployment, sentinel
+}
+
+// serverPort returns the port of the node serving the commands of client.
+func serverPort(client api.GlideClientCommands) (int, error) {
+	info, err := client.CustomCommand(context.Background(), []string{"INFO", "server"})
+	if err != nil {
+		return 0, err
+	}
+	for _, line := range strings.Split(fmt.Sprint(info), "\r\n") {
+		if port, found := strings.CutPrefix(line, "tcp_port:"); found {
+			return strconv.Atoi(port)
+		}
+	}
+	return 0, fmt.Errorf("no tcp_port in INFO server")
+}
+
+func (suite *GlideTestSuite) TestSentinel_FollowsFailover() {
+	deployment, sentinel := suite.startSentinelDeployment()
+	client := suite.client(api.NewGlideClientConfiguration().WithSentinel(sentinel).WithRequestTimeout(time.Second))
+	ctx := context.Background()
+
+	port, err := serverPort(client)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), deployment.Primaries()[0].Address.Port, port)
+	// The selected database is selected again on the new primary.
+	suite.verifyOK(client.Select(ctx, 1))
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "before"))
+	_, err = client.CustomCommand(ctx, []string{"WAIT", "1", "5000"})
+	require.NoError(suite.T(), err)
+
+	primary, err := deployment.Failover(ctx)
+	require.NoError(suite.T(), err)
+
+	// The client connects to the promoted replica once the sentinels announce it.
+	assert.Eventually(suite.T(), func() bool {
+		port, err := serverPort(client)
+		return err == nil && port == primary.Port
+	}, 10*time.Second, 100*time.Millisecond)
+	value, err := client.Get(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "before", value.Value())
+	assert.Equal(suite.T(), int64(1), client.(*api.GlideClient).CurrentDatabase())
+	suite.verifyOK(client.Set(ctx, key, "after"))
+
+	// Dedicated connections connect to the new primary as well.
+	dedicated, err := client.(*api.GlideClient).Dedicated(ctx)
+	require.NoError(suite.T(), err)
+	defer dedicated.Close()
+	port, err = serverPort(dedicated)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), primary.Port, port)
+}
+
+func (suite *GlideTestSuite) TestSentinel_ReadFromReplica() {
+	deployment, sentinel := suite.startSentinelDeployment()
+	client := suite.client(api.NewGlideClientConfiguration().WithSentinel(sentinel).WithReadFrom(api.PreferReplica))
+	ctx := context.Background()
+
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	_, err := client.CustomCommand(ctx, []string{"WAIT", "1", "5000"})
+	require.NoError(suite.T(), err)
+
+	// Reads are served by the replica discovered from the sentinels.
+	value, err := client.Get(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+	replica := suite.client(api.NewGlideClientConfiguration().WithAddress(&deployment.Replicas()[0].Address))
+	stats, err := replica.CustomCommand(ctx, []string{"INFO", "commandstats"})
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), fmt.Sprint(stats), "cmdstat_get:calls=1,")
+}
+
+func (suite *GlideTestSuite) TestSentinel_UnknownMaster() {
+	deployment, _ := suite.startSentinelDeployment()
+	sentinel := api.NewSentinelConfig("unknown")
+	for _, address := range deployment.SentinelAddresses() {
+		sentinel.WithSentinel(&address)
+	}
+	_, err := api.NewGlideClient(context.Background(), api.NewGlideClientConfiguration().WithSentinel(sentinel))
+	assert.ErrorAs(suite.T(), err, new(*errors.ConnectionError))
+	assert.ErrorContains(suite.T(), err, "unknown master")
+}
@@ -0,0 +1,151 @@
+// Copyright Valkey GLIDE Project Contributors - SPDX Identifier: Apache-2.0
+
+package integTest
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valkey-io/valkey-glide/go/api"
+	"github.com/valkey-io/valkey-glide/go/api/errors"
+	"github.com/valkey-io/valkey-glide/go/integTest/clustermanager"
+)
+
+// startShards starts count standalone servers, and returns their addresses.
+func (suite *GlideTestSuite) startShards(count int) []api.NodeAddress {
+	addresses := make([]api.NodeAddress, count)
+	for i := range addresses {
+		addresses[i] = clustermanager.StartForTest(suite.T(), clustermanager.Config{TLS: suite.tls}).Addresses()[0]
+	}
+	return addresses
+}
+
+func (suite *GlideTestSuite) shardedClient(addresses []api.NodeAddress) *api.ShardedClient {
+	config := api.NewShardedClientConfiguration().
+		WithNodeConfiguration(api.NewGlideClientConfiguration().WithUseTLS(suite.tls).WithRequestTimeout(5 * time.Second))
+	for _, address := range addresses {
+		config.WithNode(&address, 1)
+	}
+	client, err := api.NewShardedClient(context.Background(), config)
+	require.NoError(suite.T(), err)
+	suite.T().Cleanup(client.Close)
+	return client
+}
+
+func (suite *GlideTestSuite) TestShardedClient_MultiKeyCommands() {
+	addresses := suite.startShards(2)
+	client := suite.shardedClient(addresses)
+	ctx := context.Background()
+
+	prefix := uuid.NewString()
+	values := make(map[string]string)
+	keys := make([]string, 20)
+	for i := range keys {
+		keys[i] = prefix + ":" + strconv.Itoa(i)
+		values[keys[i]] = strconv.Itoa(i)
+	}
+	suite.verifyOK(client.MSet(ctx, values))
+
+	// The keys are spread between both nodes, and every node only stores its own keys.
+	for _, address := range addresses {
+		node := suite.client(api.NewGlideClientConfiguration().WithAddress(&address).WithUseTLS(suite.tls))
+		for _, key := range keys {
+			owner, err := client.NodeFor(key)
+			require.NoError(suite.T(), err)
+			exists, err := node.Exists(ctx, []string{key})
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), owner == address, exists == 1, key)
+		}
+	}
+
+	missing := prefix + ":missing"
+	fetched, err := client.MGet(ctx, append(keys, missing))
+	require.NoError(suite.T(), err)
+	for i, key := range keys {
+		assert.Equal(suite.T(), values[key], fetched[i].Value())
+	}
+	assert.True(suite.T(), fetched[len(keys)].IsNil())
+
+	count, err := client.Exists(ctx, append(keys, missing))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(len(keys)), count)
+	count, err = client.Del(ctx, keys)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(len(keys)), count)
+}
+
+func (suite *GlideTestSuite) TestShardedClient_Routing() {
+	client := suite.shardedClient(suite.startShards(2))
+	ctx := context.Background()
+
+	// Keys sharing a hash tag can be used together.
+	tag := "{" + uuid.NewString() + "}"
+	suite.verifyOK(client.Set(ctx, tag+"a", "value"))
+	_, err := client.Rename(ctx, tag+"a", tag+"b")
+	assert.NoError(suite.T(), err)
+	value, err := client.Get(ctx, tag+"b")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "value", value.Value())
+
+	// Commands whose keys are stored on different nodes fail.
+	var first, second string
+	for second == "" {
+		key := uuid.NewString()
+		node, err := client.NodeFor(key)
+		require.NoError(suite.T(), err)
+		if first == "" {
+			first = key
+		} else if firstNode, _ := client.NodeFor(first); firstNode != node {
+			second = key
+		}
+	}
+	_, err = client.SUnion(ctx, []string{first, second})
+	assert.ErrorAs(suite.T(), err, new(*errors.RequestError))
+
+	// Commands without keys fail.
+	_, err = client.ScriptFlush(ctx)
+	assert.ErrorAs(suite.T(), err, new(*errors.RequestError))
+}
+
+func (suite *GlideTestSuite) TestShardedClient_AddRemoveNode() {
+	addresses := suite.startShards(3)
+	client := suite.shardedClient(addresses[:2])
+	ctx := context.Background()
+
+	prefix := uuid.NewString()
+	keys := make([]string, 50)
+	for i := range keys {
+		keys[i] = prefix + ":" + strconv.Itoa(i)
+		suite.verifyOK(client.Set(ctx, keys[i], "value"))
+	}
+
+	require.NoError(suite.T(), client.AddNode(ctx, &addresses[2], 1))
+	assert.ErrorAs(suite.T(), client.AddNode(ctx, &addresses[2], 1), new(*errors.RequestError))
+
+	// Only the keys moved to the new node are missing.
+	moved := 0
+	for _, key := range keys {
+		owner, err := client.NodeFor(key)
+		require.NoError(suite.T(), err)
+		count, err := client.Exists(ctx, []string{key})
+		require.NoError(suite.T(), err)
+		if owner == addresses[2] {
+			moved++
+			assert.Equal(suite.T(), int64(0), count, key)
+		} else {
+			assert.Equal(suite.T(), int64(1), count, key)
+		}
+	}
+	assert.Greater(suite.T(), moved, 0)
+
+	// Removing the node moves its keys back.
+	require.NoError(suite.T(), client.RemoveNode(&addresses[2]))
+	count, err := client.Exists(ctx, keys)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(len(keys)), count)
+	assert.ErrorAs(suite.T(), client.RemoveNode(&addresses[2]), new(*errors.RequestError))
+}
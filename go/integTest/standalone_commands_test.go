@@ -6,6 +6,7 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/valkey-io/valkey-glide/go/api/options"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func (suite *GlideTestSuite) TestCustomCommandInfo() {
@@ -274,6 +276,52 @@ func (suite *GlideTestSuite) TestSelect_SwitchBetweenDatabases() {
 	assert.Equal(suite.T(), value2, result.Value())
 }
 
+func (suite *GlideTestSuite) TestSelect_SurvivesReconnect() {
+	name := uuid.New().String()
+	client := suite.client(suite.defaultClientConfig().WithConnectionsPerNode(2).WithClientName(name)).(*api.GlideClient)
+	admin := suite.defaultClient()
+	ctx := context.Background()
+	assert.Equal(suite.T(), int64(0), client.CurrentDatabase())
+	suite.verifyOK(client.Select(ctx, 3))
+	assert.Equal(suite.T(), int64(3), client.CurrentDatabase())
+	keys := []string{uuid.New().String(), uuid.New().String(), uuid.New().String()}
+	for _, key := range keys {
+		suite.verifyOK(client.Set(ctx, key, "value"))
+	}
+
+	// Closing the connections of the client makes it reconnect, to the selected database. Both connections of the client
+	// carry its name, so they are found in the client list of the server.
+	list, err := admin.CustomCommand(ctx, []string{"CLIENT", "LIST", "TYPE", "normal"})
+	require.NoError(suite.T(), err)
+	var ids []string
+	for _, line := range strings.Split(list.(string), "\n") {
+		fields := strings.Fields(line)
+		if slices.Contains(fields, "name="+name) {
+			ids = append(ids, strings.TrimPrefix(fields[0], "id="))
+		}
+	}
+	require.GreaterOrEqual(suite.T(), len(ids), 2)
+	for _, id := range ids {
+		_, err = admin.CustomCommand(ctx, []string{"CLIENT", "KILL", "ID", id})
+		require.NoError(suite.T(), err)
+	}
+	assert.Eventually(suite.T(), func() bool {
+		for _, key := range keys {
+			value, err := client.Get(ctx, key)
+			if err != nil || value.Value() != "value" {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 100*time.Millisecond)
+	assert.Equal(suite.T(), int64(3), client.CurrentDatabase())
+
+	// A database that can't be selected leaves the selected database unchanged.
+	_, err = client.Select(ctx, 1000)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), client.CurrentDatabase())
+}
+
 func (suite *GlideTestSuite) TestSortReadOnlyWithOptions_ExternalWeights() {
 	client := suite.defaultClient()
 	suite.SkipIfServerVersionLowerThanBy("7.0.0", suite.T())
@@ -1317,3 +1365,256 @@ func (suite *GlideTestSuite) TestScriptKill() {
 	assert.Error(suite.T(), err)
 	assert.True(suite.T(), strings.Contains(strings.ToLower(err.Error()), "notbusy"))
 }
+
+func (suite *GlideTestSuite) TestAclSetGetDelUser() {
+	suite.SkipIfServerVersionLowerThanBy("7.0.0", suite.T())
+	client := suite.defaultClient()
+	ctx := context.Background()
+	username := "user-" + uuid.NewString()
+
+	rules := options.NewAclRules().
+		On().
+		AddPassword("secret").
+		AllowKeys("cache:*").
+		AllowCategory("read").
+		AddSelector(options.NewAclRules().AllowWriteKeys("logs:*").AllowCommand("set"))
+	suite.verifyOK(client.AclSetUser(ctx, username, rules))
+
+	user, err := client.AclGetUser(ctx, username)
+	require.NoError(suite.T(), err)
+	require.False(suite.T(), user.IsNil())
+	assert.Contains(suite.T(), user.Value().Flags, "on")
+	assert.Len(suite.T(), user.Value().Passwords, 1)
+	assert.Equal(suite.T(), "~cache:*", user.Value().Keys)
+	assert.Contains(suite.T(), user.Value().Commands, "+@read")
+	require.Len(suite.T(), user.Value().Selectors, 1)
+	assert.Equal(suite.T(), "%W~logs:*", user.Value().Selectors[0].Keys)
+
+	users, err := client.AclUsers(ctx)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), users, username)
+	list, err := client.AclList(ctx)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), slices.ContainsFunc(list, func(line string) bool {
+		return strings.HasPrefix(line, "user "+username+" ")
+	}))
+
+	suite.verifyOK(client.AclDryRun(ctx, username, "get", []string{"cache:1"}))
+	suite.verifyOK(client.AclDryRun(ctx, username, "set", []string{"logs:1", "value"}))
+	denied, err := client.AclDryRun(ctx, username, "set", []string{"cache:1", "value"})
+	assert.NoError(suite.T(), err)
+	assert.NotEqual(suite.T(), api.OK, denied)
+
+	deleted, err := client.AclDelUser(ctx, []string{username, "missing-" + username})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), deleted)
+	user, err = client.AclGetUser(ctx, username)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), user.IsNil())
+
+	_, err = client.AclSetUser(ctx, username, options.NewAclRules().AddHashedPassword("invalid"))
+	assert.Error(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestAclLog() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	username := "user-" + uuid.NewString()
+	suite.verifyOK(client.AclSetUser(ctx, username, options.NewAclRules().On().AddPassword("secret")))
+	defer client.AclDelUser(ctx, []string{username})
+
+	_, err := client.CustomCommand(ctx, []string{"AUTH", username, "wrong"})
+	assert.Error(suite.T(), err)
+
+	entries, err := client.AclLogWithOptions(ctx, *options.NewAclLogOptions(1))
+	require.NoError(suite.T(), err)
+	require.Len(suite.T(), entries, 1)
+	assert.Equal(suite.T(), "auth", entries[0].Reason)
+	assert.Equal(suite.T(), username, entries[0].Username)
+	assert.Equal(suite.T(), int64(1), entries[0].Count)
+
+	suite.verifyOK(client.AclLogReset(ctx))
+	entries, err = client.AclLog(ctx)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), slices.ContainsFunc(entries, func(entry api.AclLogEntry) bool {
+		return entry.Username == username
+	}))
+}
+
+func (suite *GlideTestSuite) TestAclInfoCommands() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+
+	username, err := client.AclWhoAmI(ctx)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "default", username)
+
+	categories, err := client.AclCat(ctx)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), categories, "read")
+	commands, err := client.AclCatWithCategory(ctx, "read")
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), commands, "get")
+
+	password, err := client.AclGenPass(ctx)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), password, 64)
+	password, err = client.AclGenPassWithBits(ctx, 32)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), password, 8)
+
+	// The servers of the tests aren't configured with an ACL file.
+	_, err = client.AclSave(ctx)
+	assert.ErrorAs(suite.T(), err, new(*errors.RequestError))
+	_, err = client.AclLoad(ctx)
+	assert.ErrorAs(suite.T(), err, new(*errors.RequestError))
+}
+
+func (suite *GlideTestSuite) TestClientAdminCommands() {
+	suite.SkipIfServerVersionLowerThanBy("7.2.0", suite.T())
+	client := suite.defaultClient()
+	ctx := context.Background()
+
+	id, err := client.ClientId(ctx)
+	require.NoError(suite.T(), err)
+	info, err := client.ClientInfo(ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), id, info.Id)
+	clients, err := client.ClientList(ctx)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), slices.ContainsFunc(clients, func(other api.ClientInfo) bool { return other.Id == id }))
+
+	suite.verifyOK(client.ClientSetInfo(ctx, options.ClientLibName, "admin-test"))
+	suite.verifyOK(client.ClientNoEvict(ctx, true))
+	suite.verifyOK(client.ClientNoTouch(ctx, true))
+	info, err = client.ClientInfo(ctx)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "admin-test", info.LibName)
+	assert.Contains(suite.T(), info.Flags, "e")
+	assert.Contains(suite.T(), info.Flags, "T")
+	suite.verifyOK(client.ClientNoEvict(ctx, false))
+	suite.verifyOK(client.ClientNoTouch(ctx, false))
+
+	redir, err := client.ClientGetRedir(ctx)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(-1), redir)
+
+	suite.verifyOK(client.ClientPause(ctx, 100, options.PauseWrite))
+	suite.verifyOK(client.ClientUnpause(ctx))
+
+	_, err = client.ClientKill(ctx, options.NewClientKillOptions())
+	assert.Error(suite.T(), err)
+}
+
+func (suite *GlideTestSuite) TestClientKillAndUnblock() {
+	client := suite.defaultClient()
+	other := suite.defaultClient()
+	ctx := context.Background()
+	otherId, err := other.ClientId(ctx)
+	require.NoError(suite.T(), err)
+
+	// A connection blocked on an empty list is unblocked as if the command timed out.
+	popped := make(chan error, 1)
+	go func() {
+		_, err := other.BLPop(ctx, []string{uuid.NewString()}, 10)
+		popped <- err
+	}()
+	assert.Eventually(suite.T(), func() bool {
+		unblocked, err := client.ClientUnblock(ctx, otherId, false)
+		return err == nil && unblocked
+	}, 5*time.Second, 50*time.Millisecond)
+	assert.NoError(suite.T(), <-popped)
+	unblocked, err := client.ClientUnblock(ctx, otherId, true)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), unblocked)
+
+	killed, err := client.ClientKill(ctx, options.NewClientKillOptions().SetId(otherId))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), killed)
+	killed, err = client.ClientKill(ctx, options.NewClientKillOptions().SetId(otherId))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), int64(0), killed)
+}
+
+func (suite *GlideTestSuite) TestSlowLogCommands() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	suite.verifyOK(client.ConfigSet(ctx, map[string]string{"slowlog-log-slower-than": "0"}))
+	defer client.ConfigSet(ctx, map[string]string{"slowlog-log-slower-than": "10000"})
+
+	suite.verifyOK(client.SlowLogReset(ctx))
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+	length, err := client.SlowLogLen(ctx)
+	assert.NoError(suite.T(), err)
+	assert.GreaterOrEqual(suite.T(), length, int64(1))
+
+	entries, err := client.SlowLogGetWithOptions(ctx, *options.NewSlowLogOptions(-1))
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), slices.ContainsFunc(entries, func(entry api.SlowLogEntry) bool {
+		return slices.Equal(entry.Args, []string{"SET", key, "value"})
+	}))
+	entries, err = client.SlowLogGetWithOptions(ctx, *options.NewSlowLogOptions(1))
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), entries, 1)
+}
+
+func (suite *GlideTestSuite) TestLatencyCommands() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	suite.verifyOK(client.ConfigSet(ctx, map[string]string{"latency-monitor-threshold": "1"}))
+	defer client.ConfigSet(ctx, map[string]string{"latency-monitor-threshold": "0"})
+
+	_, err := client.CustomCommand(ctx, []string{"DEBUG", "SLEEP", "0.01"})
+	if err != nil {
+		suite.T().Skip("DEBUG is disabled: ", err)
+	}
+	events, err := client.LatencyLatest(ctx)
+	require.NoError(suite.T(), err)
+	require.NotEmpty(suite.T(), events)
+	samples, err := client.LatencyHistory(ctx, events[0].Name)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), samples)
+	report, err := client.LatencyDoctor(ctx)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), report)
+	cleared, err := client.LatencyReset(ctx, []string{})
+	assert.NoError(suite.T(), err)
+	assert.GreaterOrEqual(suite.T(), cleared, int64(1))
+
+	if suite.serverVersion >= "7.0.0" {
+		suite.verifyOK(client.Set(ctx, uuid.NewString(), "value"))
+		histograms, err := client.LatencyHistogram(ctx, []string{"set"})
+		require.NoError(suite.T(), err)
+		assert.GreaterOrEqual(suite.T(), histograms["set"].Calls, int64(1))
+		assert.NotEmpty(suite.T(), histograms["set"].Buckets)
+	}
+}
+
+func (suite *GlideTestSuite) TestMemoryCommands() {
+	client := suite.defaultClient()
+	ctx := context.Background()
+	key := uuid.NewString()
+	suite.verifyOK(client.Set(ctx, key, "value"))
+
+	usage, err := client.MemoryUsage(ctx, key)
+	assert.NoError(suite.T(), err)
+	assert.Greater(suite.T(), usage.Value(), int64(0))
+	usage, err = client.MemoryUsageWithOptions(ctx, key, *options.NewMemoryUsageOptions(0))
+	assert.NoError(suite.T(), err)
+	assert.Greater(suite.T(), usage.Value(), int64(0))
+	usage, err = client.MemoryUsage(ctx, uuid.NewString())
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), usage.IsNil())
+
+	stats, err := client.MemoryStats(ctx)
+	require.NoError(suite.T(), err)
+	assert.Greater(suite.T(), stats.TotalAllocated, int64(0))
+	assert.GreaterOrEqual(suite.T(), stats.KeysCount, int64(1))
+	report, err := client.MemoryDoctor(ctx)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), report)
+	_, err = client.MemoryMallocStats(ctx)
+	assert.NoError(suite.T(), err)
+	suite.verifyOK(client.MemoryPurge(ctx))
+}